@@ -0,0 +1,53 @@
+package golidator
+
+import (
+	"encoding/json"
+
+	"github.com/renxzen/golidator/internal/jsonschema"
+)
+
+// CompiledSchema validates dynamic documents, such as map[string]any and []any
+// trees decoded from JSON, against a JSON Schema
+type CompiledSchema struct {
+	root *jsonschema.Node
+}
+
+// CompileSchema parses a JSON Schema supporting the type, required, properties,
+// items, enum, format, pattern, minLength, maxLength, minimum, maximum, minItems
+// and maxItems keywords. Other keywords are ignored. Values are checked with the
+// same rules as struct fields, e.g. minLength with min and format "email" with
+// email, and errors are reported in the same form.
+func CompileSchema(data []byte) (*CompiledSchema, error) {
+	root, err := jsonschema.Compile(data)
+	if err != nil {
+		return nil, err
+	}
+	return &CompiledSchema{root: root}, nil
+}
+
+// Validate checks a document and returns validation errors
+func (s *CompiledSchema) Validate(document any) []ValidationError {
+	results := s.root.Validate(document, nil)
+	for i := range results {
+		results[i].Field = defaultValidator.engine.FormatPath(results[i].Path)
+	}
+	return results
+}
+
+// ValidateJSON decodes a JSON document and checks it. Malformed documents are
+// returned as an error.
+func (s *CompiledSchema) ValidateJSON(data []byte) ([]ValidationError, error) {
+	var document any
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	return s.Validate(document), nil
+}
+
+// Check checks a document and returns nil when it is valid or Errors when it is not
+func (s *CompiledSchema) Check(document any) error {
+	if results := s.Validate(document); len(results) > 0 {
+		return Errors(results)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package golidator
+
+import (
+	"strings"
+)
+
+// FieldError is a single failed rule of a field. It is unwrapped from Errors, so
+// errors.As(err, &golidator.FieldError{}) finds the first failure.
+type FieldError struct {
+	// Field is the field as rendered in ValidationError.Field
+	Field string
+	// Path locates the field from the root of the validated struct
+	Path Path
+	// Code is the name of the rule that failed, e.g. "email"
+	Code string
+	// Message is the error message of the rule
+	Message string
+}
+
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// Is reports whether target is a FieldError with the same Field and Code,
+// ignoring those left empty in target, e.g. errors.Is(err, FieldError{Code: "email"}).
+func (e FieldError) Is(target error) bool {
+	t, ok := target.(FieldError)
+	if !ok {
+		return false
+	}
+	return (t.Field == "" || t.Field == e.Field) && (t.Code == "" || t.Code == e.Code)
+}
+
+// Errors is the error returned by Check when validation fails
+type Errors []ValidationError
+
+func (e Errors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, fieldErr := range e.Unwrap() {
+		messages = append(messages, fieldErr.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns a FieldError for every message of every field
+func (e Errors) Unwrap() []error {
+	var errs []error
+	for _, validationError := range e {
+		for i, message := range validationError.Errors {
+			fieldErr := FieldError{
+				Field:   validationError.Field,
+				Path:    validationError.Path,
+				Message: message,
+			}
+			if i < len(validationError.Codes) {
+				fieldErr.Code = validationError.Codes[i]
+			}
+			errs = append(errs, fieldErr)
+		}
+	}
+	return errs
+}
+
+// Check validates a struct and returns nil when it is valid, Errors when it is
+// not, or the error returned by Validate
+func Check(model any) error {
+	return defaultValidator.Check(model)
+}
+
+// Check validates a struct and returns nil when it is valid, Errors when it is
+// not, or the error returned by Validate
+func (v *Validator) Check(model any) error {
+	validationErrors, err := v.Validate(model)
+	if err != nil {
+		return err
+	}
+	if len(validationErrors) > 0 {
+		return Errors(validationErrors)
+	}
+	return nil
+}
+
+// Problem converts the errors into problem details with the given status
+func (e Errors) Problem(status int) *Problem {
+	return NewProblem(status, e)
+}
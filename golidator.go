@@ -1,11 +1,19 @@
 package golidator
 
 import (
+	"database/sql/driver"
+	"encoding"
+	"reflect"
+	"regexp"
+	"time"
+
 	"github.com/renxzen/golidator/internal/engine"
 	"github.com/renxzen/golidator/internal/fieldinfo"
+	"github.com/renxzen/golidator/internal/patterns"
 	"github.com/renxzen/golidator/internal/validators"
 )
 
+// ValidateTag and JsonTag are the default tags for rules and field names
 const (
 	ValidateTag = "validate"
 	JsonTag     = "json"
@@ -14,20 +22,72 @@ const (
 // ValidationError represents a validation error for a field
 type ValidationError = engine.ValidationError
 
+// Path locates a field from the root of the validated struct
+type Path = engine.Path
+
+// PathSegment is a field name or slice index within a Path
+type PathSegment = engine.PathSegment
+
+// PathFormatter renders a Path as the Field of a ValidationError
+type PathFormatter = engine.PathFormatter
+
 // ValidatorFunc represents a validator function
 type ValidatorFunc = validators.ValidatorFunc
 
 // FieldInfo represents field information for validation
 type FieldInfo = fieldinfo.Info
 
+// FieldNameFunc returns the name reported in errors for a struct field, or an
+// empty string to use the Go field name
+type FieldNameFunc = fieldinfo.NameFunc
+
+// CustomTypeFunc returns the value to validate in place of a field value of a registered type
+type CustomTypeFunc = engine.CustomTypeFunc
+
+// Enum is implemented by named types that can be checked with a bare oneof rule
+type Enum = validators.Enum
+
 // Validate validates a struct and returns validation errors
 func Validate(model any) ([]ValidationError, error) {
-	return engine.Validate(model)
+	return defaultValidator.Validate(model)
 }
 
 // SetCaching enables or disables type caching for validation
 func SetCaching(enabled bool) {
-	engine.UseCaching = enabled
+	defaultValidator.configure(WithCaching(enabled))
+}
+
+// SetFieldNameFunc sets how fields are named in errors, e.g. TagNameFunc("form").
+// Passing nil restores the json tag.
+func SetFieldNameFunc(fn FieldNameFunc) {
+	defaultValidator.configure(WithFieldNameFunc(fn))
+}
+
+// TagNameFunc returns a FieldNameFunc reading the name from the first part of
+// the given tag, such as "json", "form", "query", "yaml", "xml" or a "label" tag.
+// A tag of "-" falls back to the Go field name.
+func TagNameFunc(tag string) FieldNameFunc {
+	return fieldinfo.TagName(tag)
+}
+
+// ProtobufFieldName is a FieldNameFunc reading the name option of protobuf tags
+func ProtobufFieldName(field reflect.StructField) string {
+	return fieldinfo.ProtobufName(field)
+}
+
+// SetPathFormatter sets how the Field of a ValidationError is rendered from its
+// Path, e.g. Path.JSONPointer. Passing nil restores the dotted notation.
+func SetPathFormatter(formatter PathFormatter) {
+	defaultValidator.configure(WithPathFormatter(formatter))
+}
+
+// SetClock sets the function used by time validators to get the current time.
+// Passing nil restores time.Now.
+func SetClock(now func() time.Time) {
+	if now == nil {
+		now = time.Now
+	}
+	validators.Now = now
 }
 
 // AddValidator adds a new validator to the registry
@@ -37,3 +97,52 @@ func AddValidator(name string, validator ValidatorFunc) {
 	}
 	validators.Registry[name] = validator
 }
+
+// RegisterPattern adds a named pattern that tags can refer to as "pattern=@name"
+func RegisterPattern(name string, pattern *regexp.Regexp) {
+	if name == "" || pattern == nil {
+		panic("pattern name cannot be empty")
+	}
+	patterns.Registry[name] = pattern
+}
+
+// RegisterCustomTypeFunc registers fn to unwrap fields of the types of the given
+// sample values before validation, e.g. sql.NullString{} or an Optional[string]{}
+func RegisterCustomTypeFunc(fn CustomTypeFunc, types ...any) {
+	if fn == nil || len(types) == 0 {
+		panic("custom type func and types cannot be empty")
+	}
+	for _, t := range types {
+		engine.CustomTypes[reflect.TypeOf(t)] = fn
+	}
+}
+
+// UnwrapValuer is a CustomTypeFunc for types implementing driver.Valuer.
+// A NULL value, or an error from Value, is validated as a nil field.
+func UnwrapValuer(value reflect.Value) any {
+	if !value.CanInterface() {
+		return nil
+	}
+
+	if valuer, ok := value.Interface().(driver.Valuer); ok {
+		if v, err := valuer.Value(); err == nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// UnwrapTextMarshaler is a CustomTypeFunc for types implementing encoding.TextMarshaler.
+// The field is validated as the marshaled string.
+func UnwrapTextMarshaler(value reflect.Value) any {
+	if !value.CanInterface() {
+		return nil
+	}
+
+	if marshaler, ok := value.Interface().(encoding.TextMarshaler); ok {
+		if text, err := marshaler.MarshalText(); err == nil {
+			return string(text)
+		}
+	}
+	return nil
+}
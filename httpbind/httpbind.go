@@ -0,0 +1,232 @@
+// Package httpbind decodes HTTP requests into structs and validates them with
+// golidator, reporting failures as RFC 7807 problem details.
+package httpbind
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/renxzen/golidator"
+)
+
+const (
+	ProblemContentType = golidator.ProblemContentType
+
+	// CodeInvalidType is the code of values that cannot be converted to their field type.
+	CodeInvalidType = golidator.CodeInvalidType
+
+	// MaxMemory is the memory used to parse multipart forms, the rest is stored on disk.
+	MaxMemory = 32 << 20
+
+	// MaxBodySize is the largest JSON body that is decoded.
+	MaxBodySize = 10 << 20
+)
+
+// Error is returned by Bind when a request cannot be decoded or fails validation.
+type Error struct {
+	// Status is the HTTP status code of the response.
+	Status int
+	// Detail explains why the request could not be decoded.
+	Detail string
+	// Errors lists the fields that could not be converted or are invalid.
+	Errors []golidator.ValidationError
+}
+
+func (e *Error) Error() string {
+	if len(e.Errors) == 0 {
+		return e.Detail
+	}
+	return fmt.Sprintf("%s: %d invalid fields", e.Detail, len(e.Errors))
+}
+
+// Problem converts the error into RFC 9457 problem details, listing the fields
+// in invalid-params.
+func (e *Error) Problem() *golidator.Problem {
+	problem := golidator.NewProblem(e.Status, e.Errors)
+	problem.Detail = e.Detail
+	return problem
+}
+
+// WriteProblem writes err as an application/problem+json response. Errors other
+// than *Error are reported as an internal server error without details.
+func WriteProblem(w http.ResponseWriter, err error) {
+	var bindErr *Error
+	if !errors.As(err, &bindErr) {
+		bindErr = &Error{Status: http.StatusInternalServerError}
+	}
+
+	_ = bindErr.Problem().Write(w)
+}
+
+// Bind decodes the request into T and validates it with golidator.
+//
+// The body is decoded according to its Content-Type: JSON for application/json
+// and other +json types, form values for application/x-www-form-urlencoded and
+// multipart/form-data. Requests without a Content-Type are decoded from the
+// query string. JSON bodies are decoded and validated with golidator.ValidateJSON,
+// form and query values with golidator.ValidateValues, so they report the same
+// errors. T may be a pointer to a struct, such as *User.
+func Bind[T any](r *http.Request) (T, error) {
+	return bind(r, binder[T]{
+		validateJSON:   golidator.ValidateJSON[T],
+		validateValues: golidator.ValidateValues[T],
+	})
+}
+
+// BindWith is like Bind but validates with the given Validator.
+func BindWith[T any](v *golidator.Validator, r *http.Request) (T, error) {
+	return bind(r, binder[T]{
+		validateJSON: func(data []byte) (T, []golidator.ValidationError, error) {
+			return golidator.ValidateJSONWith[T](v, data)
+		},
+		validateValues: func(values url.Values) (T, []golidator.ValidationError, error) {
+			return golidator.ValidateValuesWith[T](v, values)
+		},
+	})
+}
+
+// binder holds the validation functions of the Validator used by bind.
+type binder[T any] struct {
+	validateJSON   func(data []byte) (T, []golidator.ValidationError, error)
+	validateValues func(values url.Values) (T, []golidator.ValidationError, error)
+}
+
+func bind[T any](r *http.Request, b binder[T]) (T, error) {
+	var value T
+
+	values, isJSON, err := parse(r)
+	if err != nil {
+		return value, err
+	}
+
+	var validationErrors []golidator.ValidationError
+	if isJSON {
+		data, err := readJSON(r.Body)
+		if err != nil {
+			return value, err
+		}
+
+		value, validationErrors, err = b.validateJSON(data)
+		var decodeErr *golidator.DecodeError
+		if errors.As(err, &decodeErr) {
+			return value, &Error{Status: http.StatusBadRequest, Detail: "request body is not valid JSON"}
+		}
+		if err != nil {
+			return value, err
+		}
+	} else {
+		value, validationErrors, err = b.validateValues(values)
+		if err != nil {
+			return value, err
+		}
+	}
+
+	if len(validationErrors) > 0 {
+		return value, &Error{
+			Status: http.StatusBadRequest,
+			Detail: "request validation failed",
+			Errors: validationErrors,
+		}
+	}
+
+	return value, nil
+}
+
+// parse returns the form or query values of the request, or reports that its
+// body is a JSON document.
+func parse(r *http.Request) (url.Values, bool, error) {
+	contentType := r.Header.Get("Content-Type")
+	if contentType == "" {
+		return r.URL.Query(), false, nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, false, &Error{Status: http.StatusUnsupportedMediaType, Detail: "invalid content type"}
+	}
+
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return nil, true, nil
+	case mediaType == "application/x-www-form-urlencoded":
+		if err := r.ParseForm(); err != nil {
+			return nil, false, &Error{Status: http.StatusBadRequest, Detail: "malformed form body"}
+		}
+		return r.Form, false, nil
+	case mediaType == "multipart/form-data":
+		if err := r.ParseMultipartForm(MaxMemory); err != nil {
+			return nil, false, &Error{Status: http.StatusBadRequest, Detail: "malformed multipart body"}
+		}
+		return r.Form, false, nil
+	}
+
+	return nil, false, &Error{
+		Status: http.StatusUnsupportedMediaType,
+		Detail: fmt.Sprintf("unsupported content type %s", mediaType),
+	}
+}
+
+// readJSON reads a JSON body of at most MaxBodySize bytes.
+func readJSON(body io.ReadCloser) ([]byte, error) {
+	if body == nil {
+		return nil, &Error{Status: http.StatusBadRequest, Detail: "request body is empty"}
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(nil, body, MaxBodySize))
+	var sizeErr *http.MaxBytesError
+	if errors.As(err, &sizeErr) {
+		return nil, &Error{
+			Status: http.StatusRequestEntityTooLarge,
+			Detail: fmt.Sprintf("request body exceeds %d bytes", sizeErr.Limit),
+		}
+	}
+	if err != nil {
+		return nil, &Error{Status: http.StatusBadRequest, Detail: "request body could not be read"}
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, &Error{Status: http.StatusBadRequest, Detail: "request body is empty"}
+	}
+	return data, nil
+}
+
+type contextKey[T any] struct{}
+
+// Middleware binds every request into T before calling next, which can read the
+// value with FromContext. Requests that fail to bind are answered with WriteProblem.
+func Middleware[T any](next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value, err := Bind[T](r)
+		if err != nil {
+			WriteProblem(w, err)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), contextKey[T]{}, value)))
+	})
+}
+
+// FromContext returns the value bound by Middleware.
+func FromContext[T any](ctx context.Context) (T, bool) {
+	value, ok := ctx.Value(contextKey[T]{}).(T)
+	return value, ok
+}
+
+// Handler returns an http.Handler that binds every request into T and passes it
+// to fn. Requests that fail to bind are answered with WriteProblem.
+func Handler[T any](fn func(w http.ResponseWriter, r *http.Request, value T)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value, err := Bind[T](r)
+		if err != nil {
+			WriteProblem(w, err)
+			return
+		}
+		fn(w, r, value)
+	})
+}
@@ -0,0 +1,371 @@
+package httpbind_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/renxzen/golidator"
+	"github.com/renxzen/golidator/httpbind"
+)
+
+type signup struct {
+	Email string     `json:"email" validate:"required,email"`
+	Name  string     `json:"name"  validate:"alpha"`
+	Age   int        `json:"age"   validate:"min=18"`
+	Tags  []string   `json:"tags"  form:"tag"`
+	Birth *time.Time `json:"birth" form:"birth_date"`
+}
+
+func newRequest(method, target, contentType, body string) *http.Request {
+	r := httptest.NewRequest(method, target, strings.NewReader(body))
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+	return r
+}
+
+func multipartRequest(t *testing.T, fields map[string]string) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for name, value := range fields {
+		if err := writer.WriteField(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return newRequest(http.MethodPost, "/", writer.FormDataContentType(), body.String())
+}
+
+func TestBind(t *testing.T) {
+	tests := []struct {
+		name     string
+		request  func(t *testing.T) *http.Request
+		expected signup
+		status   int
+		fields   []string
+	}{
+		{
+			name: "json",
+			request: func(t *testing.T) *http.Request {
+				return newRequest(http.MethodPost, "/", "application/json", `{"email":"john@example.com","name":"John","age":30,"tags":["a","b"]}`)
+			},
+			expected: signup{Email: "john@example.com", Name: "John", Age: 30, Tags: []string{"a", "b"}},
+		},
+		{
+			name: "json_suffix_with_charset",
+			request: func(t *testing.T) *http.Request {
+				return newRequest(http.MethodPost, "/", "application/vnd.api+json; charset=utf-8", `{"email":"john@example.com","age":18}`)
+			},
+			expected: signup{Email: "john@example.com", Age: 18},
+		},
+		{
+			name: "form",
+			request: func(t *testing.T) *http.Request {
+				return newRequest(http.MethodPost, "/?name=John", "application/x-www-form-urlencoded", "email=john%40example.com&age=30&tag=a&tag=b&birth_date=2000-01-02")
+			},
+			expected: signup{
+				Email: "john@example.com",
+				Name:  "John",
+				Age:   30,
+				Tags:  []string{"a", "b"},
+				Birth: ptr(time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name: "multipart",
+			request: func(t *testing.T) *http.Request {
+				return multipartRequest(t, map[string]string{"email": "john@example.com", "age": "30"})
+			},
+			expected: signup{Email: "john@example.com", Age: 30},
+		},
+		{
+			name: "query",
+			request: func(t *testing.T) *http.Request {
+				return newRequest(http.MethodGet, "/?email=john%40example.com&age=30&tag=a", "", "")
+			},
+			expected: signup{Email: "john@example.com", Age: 30, Tags: []string{"a"}},
+		},
+		{
+			name: "validation_errors",
+			request: func(t *testing.T) *http.Request {
+				return newRequest(http.MethodPost, "/", "application/json", `{"email":"john","name":"J0","age":16}`)
+			},
+			status: http.StatusBadRequest,
+			fields: []string{"email", "name", "age"},
+		},
+		{
+			name: "conversion_errors",
+			request: func(t *testing.T) *http.Request {
+				return newRequest(http.MethodGet, "/?email=john%40example.com&age=old&birth_date=yesterday", "", "")
+			},
+			status: http.StatusBadRequest,
+			fields: []string{"age", "birth"},
+		},
+		{
+			name: "json_type_error",
+			request: func(t *testing.T) *http.Request {
+				return newRequest(http.MethodPost, "/", "application/json", `{"age":"old","name":1}`)
+			},
+			status: http.StatusBadRequest,
+			fields: []string{"name", "age", "email"},
+		},
+		{
+			name: "malformed_json",
+			request: func(t *testing.T) *http.Request {
+				return newRequest(http.MethodPost, "/", "application/json", `{"email":`)
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "empty_json",
+			request: func(t *testing.T) *http.Request {
+				return newRequest(http.MethodPost, "/", "application/json", "")
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "unsupported_content_type",
+			request: func(t *testing.T) *http.Request {
+				return newRequest(http.MethodPost, "/", "text/plain", "hello")
+			},
+			status: http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := httpbind.Bind[signup](tt.request(t))
+			if tt.status == 0 {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				if !equalSignup(value, tt.expected) {
+					t.Errorf("Expected %+v, got %+v", tt.expected, value)
+				}
+				return
+			}
+
+			bindErr, ok := err.(*httpbind.Error)
+			if !ok {
+				t.Fatalf("Expected *httpbind.Error, got %v", err)
+			}
+			if bindErr.Status != tt.status {
+				t.Errorf("Expected status %d, got %d", tt.status, bindErr.Status)
+			}
+
+			fields := make([]string, len(bindErr.Errors))
+			for i, e := range bindErr.Errors {
+				fields[i] = e.Field
+			}
+			if !slices.Equal(fields, tt.fields) {
+				t.Errorf("Expected fields %q, got %q", tt.fields, fields)
+			}
+		})
+	}
+}
+
+func TestBindSliceFields(t *testing.T) {
+	type filter struct {
+		IDs    *[]int     `json:"ids"    form:"id" validate:"max=3"`
+		Groups [][]string `json:"groups" form:"group"`
+	}
+
+	value, err := httpbind.Bind[filter](newRequest(http.MethodGet, "/?id=1&id=2", "", ""))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if value.IDs == nil || !slices.Equal(*value.IDs, []int{1, 2}) {
+		t.Errorf("Expected ids [1 2], got %v", value.IDs)
+	}
+
+	for target, field := range map[string]string{
+		"/?id=1&id=x": "ids",
+		"/?group=a":   "groups",
+	} {
+		_, err := httpbind.Bind[filter](newRequest(http.MethodGet, target, "", ""))
+		bindErr, ok := err.(*httpbind.Error)
+		if !ok || len(bindErr.Errors) != 1 || bindErr.Errors[0].Field != field {
+			t.Errorf("Expected a conversion error for %s, got %v", field, err)
+		}
+	}
+}
+
+func TestBindWith(t *testing.T) {
+	type query struct {
+		Page int `json:"page" binding:"min=1"`
+	}
+
+	v := golidator.New(golidator.WithTagName("binding"))
+	_, err := httpbind.BindWith[query](v, newRequest(http.MethodGet, "/?page=0", "", ""))
+
+	bindErr, ok := err.(*httpbind.Error)
+	if !ok || len(bindErr.Errors) != 1 || bindErr.Errors[0].Field != "page" {
+		t.Errorf("Expected an error for page, got %v", err)
+	}
+
+	type form struct {
+		Age   int    `json:"age"   form:"user_age" validate:"min=18"`
+		Email string `json:"email" validate:"email"`
+	}
+
+	v = golidator.New(golidator.WithPathFormatter(golidator.Path.JSONPointer))
+	request := newRequest(http.MethodPost, "/", "application/x-www-form-urlencoded", "user_age=old&email=john")
+	_, err = httpbind.BindWith[form](v, request)
+
+	_, expected, _ := golidator.ValidateValuesWith[form](v, url.Values{"user_age": {"old"}, "email": {"john"}})
+	bindErr, ok = err.(*httpbind.Error)
+	if !ok || !reflect.DeepEqual(bindErr.Errors, expected) || bindErr.Errors[0].Field != "/age" {
+		t.Errorf("Expected the errors of ValidateValuesWith %+v, got %v", expected, err)
+	}
+}
+
+func TestBindPointer(t *testing.T) {
+	for name, request := range map[string]*http.Request{
+		"json":  newRequest(http.MethodPost, "/", "application/json", `{"email":"john@example.com","age":30}`),
+		"query": newRequest(http.MethodGet, "/?email=john%40example.com&age=30", "", ""),
+	} {
+		t.Run(name, func(t *testing.T) {
+			value, err := httpbind.Bind[*signup](request)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if value == nil || value.Email != "john@example.com" || value.Age != 30 {
+				t.Errorf("Expected a decoded *signup, got %+v", value)
+			}
+		})
+	}
+
+	_, err := httpbind.Bind[*signup](newRequest(http.MethodPost, "/", "application/json", `{"age":16}`))
+	bindErr, ok := err.(*httpbind.Error)
+	if !ok || bindErr.Status != http.StatusBadRequest || len(bindErr.Errors) != 2 {
+		t.Errorf("Expected the errors of a *signup, got %v", err)
+	}
+
+	_, err = httpbind.Bind[string](newRequest(http.MethodPost, "/", "application/json", `"john"`))
+	if _, ok := err.(*httpbind.Error); ok || err == nil {
+		t.Errorf("Expected a plain error for a non struct type, got %v", err)
+	}
+}
+
+func TestBindJSONErrors(t *testing.T) {
+	v := golidator.New(golidator.WithPathFormatter(golidator.Path.JSONPointer))
+	data := `{"email":"john","age":"old","tags":["a",2]}`
+	_, err := httpbind.BindWith[signup](v, newRequest(http.MethodPost, "/", "application/json", data))
+
+	_, expected, _ := golidator.ValidateJSONWith[signup](v, []byte(data))
+	bindErr, ok := err.(*httpbind.Error)
+	if !ok || !reflect.DeepEqual(bindErr.Errors, expected) {
+		t.Fatalf("Expected the errors of ValidateJSONWith %+v, got %v", expected, err)
+	}
+
+	fields := make([]string, len(bindErr.Errors))
+	for i, e := range bindErr.Errors {
+		fields[i] = e.Field
+	}
+	if expected := []string{"/age", "/tags/1", "/email"}; !slices.Equal(fields, expected) {
+		t.Errorf("Expected fields %q, got %q", expected, fields)
+	}
+}
+
+func TestBindBodySize(t *testing.T) {
+	body := `{"email":"` + strings.Repeat("a", httpbind.MaxBodySize) + `"}`
+	_, err := httpbind.Bind[signup](newRequest(http.MethodPost, "/", "application/json", body))
+
+	bindErr, ok := err.(*httpbind.Error)
+	if !ok || bindErr.Status != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected a %d error, got %v", http.StatusRequestEntityTooLarge, err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	handler := httpbind.Handler(func(w http.ResponseWriter, r *http.Request, value signup) {
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(value.Email))
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest(http.MethodPost, "/", "application/json", `{"email":"john@example.com","age":30}`))
+	if recorder.Code != http.StatusCreated || recorder.Body.String() != "john@example.com" {
+		t.Errorf("Expected 201 with the email, got %d %q", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest(http.MethodPost, "/", "application/json", `{"email":"john","age":30}`))
+	assertProblem(t, recorder, http.StatusBadRequest, []string{"/email"})
+}
+
+func TestMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value, ok := httpbind.FromContext[signup](r.Context())
+		if !ok {
+			t.Error("Expected the bound value in the context")
+		}
+		_, _ = w.Write([]byte(value.Email))
+	})
+	handler := httpbind.Middleware[signup](next)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest(http.MethodGet, "/?email=john%40example.com&age=30", "", ""))
+	if recorder.Code != http.StatusOK || recorder.Body.String() != "john@example.com" {
+		t.Errorf("Expected 200 with the email, got %d %q", recorder.Code, recorder.Body.String())
+	}
+
+	recorder = httptest.NewRecorder()
+	handler.ServeHTTP(recorder, newRequest(http.MethodGet, "/?age=old", "", ""))
+	assertProblem(t, recorder, http.StatusBadRequest, []string{"/age", "/email"})
+}
+
+func TestWriteProblemUnknownError(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	httpbind.WriteProblem(recorder, bytes.ErrTooLarge)
+	assertProblem(t, recorder, http.StatusInternalServerError, nil)
+}
+
+func assertProblem(t *testing.T, recorder *httptest.ResponseRecorder, status int, fields []string) {
+	t.Helper()
+
+	if recorder.Code != status {
+		t.Errorf("Expected status %d, got %d", status, recorder.Code)
+	}
+	if contentType := recorder.Header().Get("Content-Type"); contentType != httpbind.ProblemContentType {
+		t.Errorf("Expected content type %q, got %q", httpbind.ProblemContentType, contentType)
+	}
+
+	var body golidator.Problem
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Status != status || body.Title != http.StatusText(status) || body.Type != "about:blank" {
+		t.Errorf("Unexpected problem %+v", body)
+	}
+
+	got := make([]string, len(body.InvalidParams))
+	for i, param := range body.InvalidParams {
+		got[i] = param.Pointer
+	}
+	if !slices.Equal(got, fields) && (len(got) != 0 || len(fields) != 0) {
+		t.Errorf("Expected fields %q, got %q", fields, got)
+	}
+}
+
+func equalSignup(a, b signup) bool {
+	if (a.Birth == nil) != (b.Birth == nil) || (a.Birth != nil && !a.Birth.Equal(*b.Birth)) {
+		return false
+	}
+	return a.Email == b.Email && a.Name == b.Name && a.Age == b.Age && slices.Equal(a.Tags, b.Tags)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -13,37 +13,53 @@ type TypeInfo struct {
 }
 
 type TypeCache struct {
-	mu    sync.RWMutex
-	cache map[reflect.Type]*TypeInfo
+	mu      sync.RWMutex
+	cache   map[reflect.Type]*TypeInfo
+	options fieldinfo.Options
+	// version is the fieldinfo.RulesVersion the cached types were computed with.
+	version uint64
 }
 
-func NewTypeCache() *TypeCache {
+func NewTypeCache(options fieldinfo.Options) *TypeCache {
 	return &TypeCache{
-		cache: make(map[reflect.Type]*TypeInfo),
+		cache:   make(map[reflect.Type]*TypeInfo),
+		options: options,
+		version: fieldinfo.RulesVersion(),
 	}
 }
 
-func (tc *TypeCache) Get(t reflect.Type) *TypeInfo {
+func (tc *TypeCache) Get(t reflect.Type) (*TypeInfo, error) {
+	version := fieldinfo.RulesVersion()
+
 	tc.mu.RLock()
 	info, exists := tc.cache[t]
+	current := tc.version >= version
 	tc.mu.RUnlock()
-	if exists {
-		return info
+	if exists && current {
+		return info, nil
 	}
 
 	tc.mu.Lock()
 	defer tc.mu.Unlock()
 
+	if tc.version < version {
+		tc.cache = make(map[reflect.Type]*TypeInfo)
+		tc.version = version
+	}
+
 	if info, exists := tc.cache[t]; exists {
-		return info
+		return info, nil
 	}
 
-	computedInfo := tc.computeTypeInfo(t)
+	computedInfo, err := tc.computeTypeInfo(t)
+	if err != nil {
+		return nil, err
+	}
 	tc.cache[t] = computedInfo
-	return computedInfo
+	return computedInfo, nil
 }
 
-func (tc *TypeCache) computeTypeInfo(t reflect.Type) *TypeInfo {
+func (tc *TypeCache) computeTypeInfo(t reflect.Type) (*TypeInfo, error) {
 	numField := t.NumField()
 	info := &TypeInfo{
 		Type:   t,
@@ -53,16 +69,22 @@ func (tc *TypeCache) computeTypeInfo(t reflect.Type) *TypeInfo {
 	dummyValue := reflect.New(t).Elem()
 
 	for i := 0; i < numField; i++ {
-		fieldInfo := fieldinfo.ExtractInfo(dummyValue, i)
+		fieldInfo, err := fieldinfo.ExtractInfo(dummyValue, i, tc.options)
+		if err != nil {
+			return nil, err
+		}
 		fieldInfo.Value = reflect.Value{}
 		info.Fields[i] = fieldInfo
 	}
 
-	return info
+	return info, nil
 }
 
-func (tc *TypeCache) GetWithValues(t reflect.Type, value reflect.Value) *TypeInfo {
-	typeInfo := tc.Get(t)
+func (tc *TypeCache) GetWithValues(t reflect.Type, value reflect.Value) (*TypeInfo, error) {
+	typeInfo, err := tc.Get(t)
+	if err != nil {
+		return nil, err
+	}
 
 	result := &TypeInfo{
 		Type:   typeInfo.Type,
@@ -74,7 +96,7 @@ func (tc *TypeCache) GetWithValues(t reflect.Type, value reflect.Value) *TypeInf
 		result.Fields[i] = fieldInfo
 	}
 
-	return result
+	return result, nil
 }
 
 func (tc *TypeCache) Clear() {
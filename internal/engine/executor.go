@@ -3,7 +3,8 @@ package engine
 import (
 	"fmt"
 	"reflect"
-	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/renxzen/golidator/internal/cache"
 	"github.com/renxzen/golidator/internal/fieldinfo"
@@ -13,14 +14,151 @@ import (
 type ValidationError struct {
 	Field  string   `json:"field"`
 	Errors []string `json:"errors"`
+	// Codes holds the name of the rule that produced each message in Errors.
+	Codes []string `json:"-"`
+	// Path locates the field from the root of the validated struct. Field is
+	// rendered from it with the configured PathFormatter.
+	Path Path `json:"-"`
 }
 
-var (
-	UseCaching = true
-	typeCache  = cache.NewTypeCache()
-)
+// CustomTypeFunc returns the value to validate in place of a field value of a registered type.
+type CustomTypeFunc func(value reflect.Value) any
+
+var CustomTypes = map[reflect.Type]CustomTypeFunc{}
+
+// Config holds the settings of an Engine.
+type Config struct {
+	UseCaching bool
+	Tag        string
+	FieldName  fieldinfo.NameFunc
+	PathFormat PathFormatter
+}
+
+// DefaultConfig reads rules from the validate tag, names fields after the json
+// tag and renders paths in dotted notation.
+func DefaultConfig() Config {
+	options := fieldinfo.DefaultOptions()
+	return Config{
+		UseCaching: true,
+		Tag:        options.Tag,
+		FieldName:  options.Name,
+		PathFormat: Path.String,
+	}
+}
+
+// Engine validates structs with its own configuration and type cache. It is
+// safe for concurrent use, including with Configure.
+type Engine struct {
+	// mu serializes Configure, validations read the current snapshot.
+	mu      sync.Mutex
+	current atomic.Pointer[snapshot]
+}
+
+// snapshot is a configuration with the type cache built for it. A validation
+// runs against a single snapshot, even when the Engine is reconfigured meanwhile.
+type snapshot struct {
+	config    Config
+	typeCache *cache.TypeCache
+}
 
-func Validate(model any) ([]ValidationError, error) {
+func New(config Config) *Engine {
+	e := &Engine{}
+	e.Configure(func(c *Config) { *c = config })
+	return e
+}
+
+// Configure updates the configuration. The cached type information depends on
+// the tag and the field names, so it is only dropped when either is set.
+func (e *Engine) Configure(update func(config *Config)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	current := e.current.Load()
+	var config Config
+	if current != nil {
+		config = current.config
+	}
+
+	// Functions cannot be compared, so FieldName is cleared while update runs
+	// to tell whether it sets a new one.
+	fieldName := config.FieldName
+	config.FieldName = nil
+	update(&config)
+	renamed := config.FieldName != nil
+	if !renamed {
+		config.FieldName = fieldName
+	}
+
+	next := &snapshot{config: config}
+	if current != nil && !renamed && current.config.Tag == config.Tag {
+		next.typeCache = current.typeCache
+	} else {
+		next.typeCache = cache.NewTypeCache(next.fieldOptions())
+	}
+	e.current.Store(next)
+}
+
+func (s *snapshot) fieldOptions() fieldinfo.Options {
+	return fieldinfo.Options{
+		Tag:  s.config.Tag,
+		Name: s.config.FieldName,
+	}
+}
+
+// TypeInfo returns the cached field information of a struct type.
+func (e *Engine) TypeInfo(t reflect.Type) (*cache.TypeInfo, error) {
+	return e.current.Load().typeCache.Get(t)
+}
+
+// FieldName returns the name of a struct field in errors.
+func (e *Engine) FieldName(field reflect.StructField) string {
+	return e.current.Load().fieldOptions().FieldName(field)
+}
+
+// FormatPath renders a path as the Field of a ValidationError.
+func (e *Engine) FormatPath(path Path) string {
+	return e.current.Load().config.PathFormat(path)
+}
+
+func (e *Engine) Validate(model any) ([]ValidationError, error) {
+	return e.ValidatePresence(model, nil)
+}
+
+// ValidatePresence validates a model decoded from a JSON document whose keys
+// are recorded in presence. The required rule then reports absent and null keys
+// for every field, and the other rules skip them. A nil presence validates the
+// model like Validate.
+func (e *Engine) ValidatePresence(model any, presence *Presence) ([]ValidationError, error) {
+	s := e.current.Load()
+	v := &validation{snapshot: s, visiting: map[visit]bool{}}
+	results, err := v.validateModel(model, nil, presence)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		results[i].Field = s.config.PathFormat(results[i].Path)
+	}
+	return results, nil
+}
+
+// validation runs a single Validate call against a snapshot.
+type validation struct {
+	*snapshot
+	// visiting holds the structs being validated, from the root down to the
+	// current field, so that structs reachable from themselves through
+	// interface or slice fields are only validated once.
+	visiting map[visit]bool
+}
+
+type visit struct {
+	typ  reflect.Type
+	addr uintptr
+}
+
+// validateModel validates a struct, or a pointer to one, whose fields are
+// located under prefix.
+func (s *validation) validateModel(model any, prefix Path, presence *Presence) ([]ValidationError, error) {
 	value := reflect.ValueOf(model)
 	kind := value.Kind()
 
@@ -37,22 +175,35 @@ func Validate(model any) ([]ValidationError, error) {
 		return nil, fmt.Errorf("model must be a struct, got %s", kind)
 	}
 
-	if UseCaching {
-		return validateWithCache(value)
+	if value.CanAddr() {
+		key := visit{typ: value.Type(), addr: value.UnsafeAddr()}
+		if s.visiting[key] {
+			return nil, nil
+		}
+		s.visiting[key] = true
+		defer delete(s.visiting, key)
+	}
+
+	if s.config.UseCaching {
+		return s.validateWithCache(value, prefix, presence)
 	}
-	return validateWithoutCache(value)
+	return s.validateWithoutCache(value, prefix, presence)
 }
 
-func validateWithCache(value reflect.Value) ([]ValidationError, error) {
-	typeInfo := typeCache.GetWithValues(value.Type(), value)
+func (s *validation) validateWithCache(value reflect.Value, prefix Path, presence *Presence) ([]ValidationError, error) {
+	typeInfo, err := s.typeCache.GetWithValues(value.Type(), value)
+	if err != nil {
+		return nil, err
+	}
 	results := make([]ValidationError, 0, len(typeInfo.Fields))
 
 	for _, fieldInfo := range typeInfo.Fields {
-		if fieldInfo.ValidateTag == "" {
+		if fieldInfo.ValidateTag == "" && !isInterface(fieldInfo) {
 			continue
 		}
 
-		validationResults, err := executeFieldValidation(fieldInfo)
+		node, tracked := presence.lookup(value.Type(), fieldInfo.Index)
+		validationResults, err := s.executeFieldValidation(fieldInfo, prefix, node, tracked)
 		if err != nil {
 			return nil, err
 		}
@@ -61,18 +212,23 @@ func validateWithCache(value reflect.Value) ([]ValidationError, error) {
 	return results, nil
 }
 
-func validateWithoutCache(value reflect.Value) ([]ValidationError, error) {
+func (s *validation) validateWithoutCache(value reflect.Value, prefix Path, presence *Presence) ([]ValidationError, error) {
 	numField := value.NumField()
 	results := make([]ValidationError, 0, numField)
+	options := s.fieldOptions()
 
 	for i := range numField {
-		fieldInfo := fieldinfo.ExtractInfo(value, i)
+		fieldInfo, err := fieldinfo.ExtractInfo(value, i, options)
+		if err != nil {
+			return nil, err
+		}
 
-		if fieldInfo.ValidateTag == "" {
+		if fieldInfo.ValidateTag == "" && !isInterface(fieldInfo) {
 			continue
 		}
 
-		validationResults, err := executeFieldValidation(fieldInfo)
+		node, tracked := presence.lookup(value.Type(), fieldInfo.Index)
+		validationResults, err := s.executeFieldValidation(fieldInfo, prefix, node, tracked)
 		if err != nil {
 			return nil, err
 		}
@@ -81,47 +237,124 @@ func validateWithoutCache(value reflect.Value) ([]ValidationError, error) {
 	return results, nil
 }
 
-func executeFieldValidation(fieldInfo fieldinfo.Info) ([]ValidationError, error) {
-	if fieldInfo.ValidateTag == "" {
+// executeFieldValidation runs the rules of a field. When tracked is set, node
+// holds the presence of the field in the JSON document, nil if it is absent.
+func (s *validation) executeFieldValidation(fieldInfo fieldinfo.Info, prefix Path, node *Presence, tracked bool) ([]ValidationError, error) {
+	dynamic := isInterface(fieldInfo)
+	if fieldInfo.ValidateTag == "" && !dynamic {
 		return nil, nil
 	}
 
-	validators := strings.Split(fieldInfo.ValidateTag, ",")
-	var valErrors []string
+	fieldInfo = resolveCustomType(resolveIndirection(fieldInfo))
+	path := prefix.FieldKey(fieldInfo.JSONName, fieldInfo.JSONKey)
+	absent := tracked && (node == nil || node.Null)
+
+	var valErrors, codes []string
 	var results []ValidationError
 
-	for _, validator := range validators {
-		validator = strings.TrimSpace(validator)
-		if validator == "" {
+	for _, validatorName := range fieldInfo.Validators {
+		if absent && validatorName != "required" {
 			continue
 		}
 
-		validatorName := validator
-		if idx := strings.IndexByte(validator, '='); idx != -1 {
-			validatorName = validator[:idx]
+		var errorMsg string
+		if tracked && validatorName == "required" {
+			errorMsg = checkPresence(node)
+		} else {
+			errorMsg = executeValidator(validatorName, fieldInfo)
 		}
-
-		errorMsg := executeValidator(validatorName, fieldInfo)
 		if errorMsg != "" {
 			valErrors = append(valErrors, errorMsg)
+			codes = append(codes, validatorName)
 		}
 
 		if validatorName == "isarray" && errorMsg == "" {
-			nestedResults := handleArrayValidation(fieldInfo)
+			nestedResults := s.handleArrayValidation(fieldInfo, path, node)
 			results = append(results, nestedResults...)
 		}
 	}
 
 	if len(valErrors) > 0 {
 		results = append(results, ValidationError{
-			Field:  fieldInfo.JSONName,
 			Errors: valErrors,
+			Codes:  codes,
+			Path:   path,
 		})
 	}
 
+	if dynamic {
+		nestedResults, err := s.handleDynamicStruct(fieldInfo, path, node)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, nestedResults...)
+	}
+
 	return results, nil
 }
 
+// isInterface reports whether the field is declared as an interface, such as
+// `any`, or as a pointer to one.
+func isInterface(fieldInfo fieldinfo.Info) bool {
+	return fieldInfo.OriginalKind == reflect.Interface || fieldInfo.Kind == reflect.Interface
+}
+
+// resolveIndirection replaces interface fields and pointers to pointers, such as
+// **string, with the value they ultimately hold so that rules run against it.
+func resolveIndirection(fieldInfo fieldinfo.Info) fieldinfo.Info {
+	if !isInterface(fieldInfo) && fieldInfo.Kind != reflect.Pointer {
+		return fieldInfo
+	}
+
+	value := fieldInfo.Value
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return fieldInfo.WithValue(reflect.Value{})
+		}
+		value = value.Elem()
+	}
+
+	return fieldInfo.WithValue(value)
+}
+
+// handleDynamicStruct validates a struct held by an interface field, reporting
+// its errors under the path of the field.
+func (s *validation) handleDynamicStruct(fieldInfo fieldinfo.Info, path Path, node *Presence) ([]ValidationError, error) {
+	value := fieldInfo.GetValue()
+	if fieldInfo.IsNil() || value.Kind() != reflect.Struct || !value.CanInterface() {
+		return nil, nil
+	}
+
+	// The address of the struct is kept so that cycles are detected.
+	if value.CanAddr() {
+		return s.validateModel(value.Addr().Interface(), path, node)
+	}
+	return s.validateModel(value.Interface(), path, node)
+}
+
+// resolveCustomType replaces the value of fields whose type has a registered
+// CustomTypeFunc, so that rules run against the unwrapped value.
+func resolveCustomType(fieldInfo fieldinfo.Info) fieldinfo.Info {
+	customTypeFunc, exists := CustomTypes[fieldInfo.Type]
+	if !exists || fieldInfo.IsNil() {
+		return fieldInfo
+	}
+
+	return fieldInfo.WithValue(reflect.ValueOf(customTypeFunc(fieldInfo.GetValue())))
+}
+
+// RunRules runs the rules of fieldInfo against its value, returning the error
+// messages along with the names of the rules that produced them.
+func RunRules(fieldInfo fieldinfo.Info) (messages []string, codes []string) {
+	for _, validatorName := range fieldInfo.Validators {
+		if errorMsg := executeValidator(validatorName, fieldInfo); errorMsg != "" {
+			messages = append(messages, errorMsg)
+			codes = append(codes, validatorName)
+		}
+	}
+	return messages, codes
+}
+
 func executeValidator(validatorName string, fieldInfo fieldinfo.Info) string {
 	valFunc, exists := validators.Registry[validatorName]
 	if !exists {
@@ -131,28 +364,28 @@ func executeValidator(validatorName string, fieldInfo fieldinfo.Info) string {
 	return valFunc(fieldInfo)
 }
 
-func handleArrayValidation(fieldInfo fieldinfo.Info) []ValidationError {
+func (s *validation) handleArrayValidation(fieldInfo fieldinfo.Info, path Path, node *Presence) []ValidationError {
 	var results []ValidationError
 	validationValue := fieldInfo.GetValue()
 
 	if fieldInfo.Kind == reflect.Slice {
 		for j := 0; j < validationValue.Len(); j++ {
-			result, err := Validate(validationValue.Index(j).Interface())
+			elemPath := path.Index(j)
+			elem := validationValue.Index(j)
+			if elem.Kind() == reflect.Struct {
+				elem = elem.Addr()
+			}
+			result, err := s.validateModel(elem.Interface(), elemPath, node.item(j))
 			if err != nil {
 				results = append(results, ValidationError{
-					Field:  fmt.Sprintf("%s[%d]", fieldInfo.JSONName, j),
 					Errors: []string{err.Error()},
+					Codes:  []string{"isarray"},
+					Path:   elemPath,
 				})
 				continue
 			}
 
-			for _, arr := range result {
-				subFieldName := fmt.Sprintf("%s[%d]: %s", fieldInfo.JSONName, j, arr.Field)
-				results = append(results, ValidationError{
-					Field:  subFieldName,
-					Errors: arr.Errors,
-				})
-			}
+			results = append(results, result...)
 		}
 	}
 
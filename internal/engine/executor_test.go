@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"encoding/json"
+	"net/netip"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+func TestConfigureKeepsTypeCache(t *testing.T) {
+	type model struct {
+		Name string `json:"name" validate:"required"`
+	}
+
+	e := New(DefaultConfig())
+	typeCache := e.current.Load().typeCache
+	if _, err := e.TypeInfo(reflect.TypeFor[model]()); err != nil {
+		t.Fatal(err)
+	}
+
+	e.Configure(func(config *Config) {
+		config.UseCaching = false
+		config.PathFormat = Path.JSONPointer
+	})
+	if e.current.Load().typeCache != typeCache || typeCache.Size() != 1 {
+		t.Error("Expected the type cache to be kept when neither the tag nor the field names change")
+	}
+
+	e.Configure(func(config *Config) { config.FieldName = fieldinfo.TagName("form") })
+	if e.current.Load().typeCache == typeCache {
+		t.Error("Expected a new type cache when the field names change")
+	}
+
+	typeCache = e.current.Load().typeCache
+	e.Configure(func(config *Config) { config.Tag = "binding" })
+	if e.current.Load().typeCache == typeCache {
+		t.Error("Expected a new type cache when the tag changes")
+	}
+}
+
+func TestCheckTypes(t *testing.T) {
+	type Base struct {
+		ID    uint8 `json:"id"`
+		Label string
+	}
+
+	type model struct {
+		Base
+		Label   int               `json:"label"`
+		When    time.Time         `json:"when"`
+		Addr    netip.Addr        `json:"addr"`
+		Quoted  int               `json:"quoted,string"`
+		Counts  map[int]int       `json:"counts"`
+		Grid    [1][]float32      `json:"grid"`
+		Payload any               `json:"payload"`
+		Data    []byte            `json:"data"`
+		Tags    map[string]string `json:"tags"`
+		Ignored int               `json:"-"`
+	}
+
+	tests := []struct {
+		name     string
+		document string
+		paths    []string
+	}{
+		{
+			name:     "valid",
+			document: `{"id":255,"label":1,"Label":"a","when":"2024-01-01T00:00:00Z","addr":"::1","quoted":"1","counts":{"1":2},"grid":[[1.5],[true]],"payload":{"a":[1]},"data":"AQ==","tags":null,"Ignored":"x"}`,
+		},
+		{
+			name:     "invalid",
+			document: `{"id":256,"label":"one","Label":2,"addr":1,"counts":{"a":1,"2":"b"},"grid":[["x"]],"data":[1,"a"],"tags":{"a":1}}`,
+			paths:    []string{"id", "Label", "label", "addr", "counts.2", "counts.a", "grid[0][0]", "data[1]", "tags.a"},
+		},
+	}
+
+	e := New(DefaultConfig())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			presence, err := ParsePresence([]byte(tt.document))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var paths []string
+			for _, typeErr := range e.CheckTypes(reflect.TypeFor[model](), presence) {
+				paths = append(paths, typeErr.Path.String())
+			}
+			if !slices.Equal(paths, tt.paths) {
+				t.Errorf("Expected type errors at %q, got %q", tt.paths, paths)
+			}
+
+			err = json.Unmarshal([]byte(tt.document), new(model))
+			if (err != nil) != (len(tt.paths) > 0) {
+				t.Errorf("Expected json.Unmarshal to agree, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package engine
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+// ruleSegment is a step of a rule key: a map key, a slice index, or every
+// element of a slice when wildcard is set.
+type ruleSegment struct {
+	name     string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// match is a value located by a rule key, invalid when it is missing.
+type match struct {
+	value reflect.Value
+	path  Path
+}
+
+// ValidateMap validates the values of a dynamic document located by the keys
+// of rules, e.g. "email", "user.address.city", "items[0].name" or
+// "items[*].name". The rules use the same syntax as tags. Missing and null
+// values are reported by required and skipped by the other rules.
+func (e *Engine) ValidateMap(data any, rules map[string]string) ([]ValidationError, error) {
+	keys := make([]string, 0, len(rules))
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var results []ValidationError
+	for _, key := range keys {
+		segments, err := parseRuleKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := fieldinfo.ParseTag(rules[key])
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", key, err)
+		}
+
+		for _, m := range resolve(reflect.ValueOf(data), segments, nil) {
+			fieldInfo := resolveCustomType(info.WithValue(NormalizeNumber(m.value)))
+			if !m.value.IsValid() {
+				fieldInfo.Validators = slices.DeleteFunc(slices.Clone(fieldInfo.Validators), func(name string) bool {
+					return name != "required"
+				})
+			}
+			fieldInfo.Name = key
+			if len(m.path) > 0 {
+				fieldInfo.JSONName = m.path[len(m.path)-1].Name
+			}
+
+			messages, codes := RunRules(fieldInfo)
+			if len(messages) > 0 {
+				results = append(results, ValidationError{
+					Field:  e.FormatPath(m.path),
+					Errors: messages,
+					Codes:  codes,
+					Path:   m.path,
+				})
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// parseRuleKey splits a rule key into map keys separated by dots, each followed
+// by any number of "[n]" indexes or "[*]" wildcards. A "*" key is a wildcard too.
+func parseRuleKey(key string) ([]ruleSegment, error) {
+	if key == "" {
+		return nil, fmt.Errorf("rule key cannot be empty")
+	}
+
+	var segments []ruleSegment
+	for i, part := range strings.Split(key, ".") {
+		name, brackets, _ := strings.Cut(part, "[")
+		switch {
+		case name == "*":
+			segments = append(segments, ruleSegment{isIndex: true, wildcard: true})
+		case name != "":
+			segments = append(segments, ruleSegment{name: name})
+		case i > 0 || brackets == "":
+			return nil, fmt.Errorf("invalid rule key %q", key)
+		}
+
+		if !strings.Contains(part, "[") {
+			continue
+		}
+
+		for _, index := range strings.Split(brackets, "[") {
+			index, ok := strings.CutSuffix(index, "]")
+			if !ok {
+				return nil, fmt.Errorf("invalid rule key %q", key)
+			}
+			if index == "*" {
+				segments = append(segments, ruleSegment{isIndex: true, wildcard: true})
+				continue
+			}
+			n, err := strconv.Atoi(index)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid index %q in rule key %q", index, key)
+			}
+			segments = append(segments, ruleSegment{index: n, isIndex: true})
+		}
+	}
+
+	return segments, nil
+}
+
+// resolve follows segments from value, returning every located value. Values
+// that are missing, or that cannot hold the next segment, are returned invalid,
+// except under a wildcard which then matches nothing.
+func resolve(value reflect.Value, segments []ruleSegment, path Path) []match {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			value = reflect.Value{}
+			break
+		}
+		value = value.Elem()
+	}
+
+	if len(segments) == 0 {
+		return []match{{value: value, path: path}}
+	}
+
+	segment, rest := segments[0], segments[1:]
+	isSlice := value.Kind() == reflect.Slice || value.Kind() == reflect.Array
+
+	switch {
+	case segment.wildcard:
+		if !isSlice {
+			return nil
+		}
+		var matches []match
+		for i := range value.Len() {
+			matches = append(matches, resolve(value.Index(i), rest, path.Index(i))...)
+		}
+		return matches
+	case segment.isIndex:
+		var elem reflect.Value
+		if isSlice && segment.index < value.Len() {
+			elem = value.Index(segment.index)
+		}
+		return resolve(elem, rest, path.Index(segment.index))
+	}
+
+	var elem reflect.Value
+	if value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String {
+		elem = value.MapIndex(reflect.ValueOf(segment.name).Convert(value.Type().Key()))
+	}
+	return resolve(elem, rest, path.Field(segment.name))
+}
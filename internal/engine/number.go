@@ -0,0 +1,26 @@
+package engine
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+)
+
+var jsonNumberType = reflect.TypeFor[json.Number]()
+
+// NormalizeNumber converts a json.Number, as decoded with json.Decoder.UseNumber,
+// to an int64 or, when it is not an integer, a float64 so that rules check it as
+// a number. Other values are returned unchanged.
+func NormalizeNumber(value reflect.Value) reflect.Value {
+	if !value.IsValid() || value.Type() != jsonNumberType {
+		return value
+	}
+
+	if n, err := strconv.ParseInt(value.String(), 10, 64); err == nil {
+		return reflect.ValueOf(n)
+	}
+	if f, err := strconv.ParseFloat(value.String(), 64); err == nil {
+		return reflect.ValueOf(f)
+	}
+	return value
+}
@@ -0,0 +1,92 @@
+package engine
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PathSegment is one step of a Path: a field name, or a slice index when IsIndex is set.
+type PathSegment struct {
+	Name    string
+	Index   int
+	IsIndex bool
+	// Key is the JSON key of a struct field, which may differ from its Name in
+	// errors. It is empty for segments that are not struct fields, such as map
+	// keys, whose Name is the key.
+	Key string
+}
+
+// Path locates a field from the root of the validated struct.
+type Path []PathSegment
+
+// PathFormatter renders a Path as the Field of a ValidationError.
+type PathFormatter func(path Path) string
+
+// String renders the path in dotted notation, e.g. "items[0].name".
+func (p Path) String() string {
+	var sb strings.Builder
+	for i, segment := range p {
+		if segment.IsIndex {
+			sb.WriteByte('[')
+			sb.WriteString(strconv.Itoa(segment.Index))
+			sb.WriteByte(']')
+			continue
+		}
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(segment.Name)
+	}
+	return sb.String()
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// JSONPointer renders the path as an RFC 6901 JSON Pointer, e.g. "/items/0/name".
+func (p Path) JSONPointer() string {
+	var sb strings.Builder
+	for _, segment := range p {
+		sb.WriteByte('/')
+		if segment.IsIndex {
+			sb.WriteString(strconv.Itoa(segment.Index))
+			continue
+		}
+		pointerEscaper.WriteString(&sb, segment.Name)
+	}
+	return sb.String()
+}
+
+// DocumentPointer renders the path as an RFC 6901 JSON Pointer into the JSON
+// document the struct is decoded from, using the JSON key of each field rather
+// than its name in errors.
+func (p Path) DocumentPointer() string {
+	var sb strings.Builder
+	for _, segment := range p {
+		sb.WriteByte('/')
+		switch {
+		case segment.IsIndex:
+			sb.WriteString(strconv.Itoa(segment.Index))
+		case segment.Key != "":
+			pointerEscaper.WriteString(&sb, segment.Key)
+		default:
+			pointerEscaper.WriteString(&sb, segment.Name)
+		}
+	}
+	return sb.String()
+}
+
+// Field returns a copy of the path extended with a field name.
+func (p Path) Field(name string) Path {
+	return append(p[:len(p):len(p)], PathSegment{Name: name})
+}
+
+// FieldKey returns a copy of the path extended with a struct field named name
+// in errors and decoded from the JSON key key.
+func (p Path) FieldKey(name, key string) Path {
+	return append(p[:len(p):len(p)], PathSegment{Name: name, Key: key})
+}
+
+// Index returns a copy of the path extended with a slice index.
+func (p Path) Index(i int) Path {
+	return append(p[:len(p):len(p)], PathSegment{Index: i, IsIndex: true})
+}
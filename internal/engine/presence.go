@@ -0,0 +1,117 @@
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+	"github.com/renxzen/golidator/internal/validators"
+)
+
+// Presence records the shape of a decoded JSON document: the keys present in
+// objects, the elements of arrays and explicit nulls.
+type Presence struct {
+	Null   bool
+	Fields map[string]*Presence
+	Items  []*Presence
+	// value holds the string, json.Number or bool of scalar values.
+	value any
+}
+
+// ParsePresence records the keys and nulls present in a JSON document.
+func ParsePresence(data []byte) (*Presence, error) {
+	// json.Unmarshal reports malformed documents, the decoder then keeps
+	// numbers as written so that CheckTypes can tell integers apart.
+	var raw json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	return newPresence(document), nil
+}
+
+func newPresence(value any) *Presence {
+	switch value := value.(type) {
+	case nil:
+		return &Presence{Null: true}
+	case map[string]any:
+		fields := make(map[string]*Presence, len(value))
+		for key, elem := range value {
+			fields[key] = newPresence(elem)
+		}
+		return &Presence{Fields: fields}
+	case []any:
+		items := make([]*Presence, len(value))
+		for i, elem := range value {
+			items[i] = newPresence(elem)
+		}
+		return &Presence{Items: items}
+	}
+	return &Presence{value: value}
+}
+
+// lookup returns the presence of the key decoded into the field at index of
+// structType, matching keys like encoding/json does, or nil when the key is
+// absent. It reports false when p does not describe an object, or when the
+// field is tagged `json:"-"` and never decoded, so presence is not tracked and
+// the value of the field is checked instead.
+func (p *Presence) lookup(structType reflect.Type, index int) (*Presence, bool) {
+	if p == nil || p.Fields == nil {
+		return nil, false
+	}
+
+	field := structType.Field(index)
+	key := fieldinfo.TagName(fieldinfo.JsonTag)(field)
+	if key == "" {
+		if field.Tag.Get(fieldinfo.JsonTag) == "-" {
+			return nil, false
+		}
+		key = field.Name
+	}
+
+	node, _ := p.key(key)
+	return node, true
+}
+
+// key returns the presence of the value of key in an object, matching keys
+// like encoding/json does: exactly, then ignoring case.
+func (p *Presence) key(key string) (*Presence, bool) {
+	if node, exists := p.Fields[key]; exists {
+		return node, true
+	}
+	for name, node := range p.Fields {
+		if strings.EqualFold(name, key) {
+			return node, true
+		}
+	}
+	return nil, false
+}
+
+// item returns the presence of the element at index i, or nil when unknown.
+func (p *Presence) item(i int) *Presence {
+	if p == nil || i >= len(p.Items) {
+		return nil
+	}
+	return p.Items[i]
+}
+
+// checkPresence runs the required rule against the presence of the field in
+// the document: absent and null keys are reported whatever the field type,
+// and present keys pass even when they hold a zero value.
+func checkPresence(node *Presence) string {
+	switch {
+	case node == nil:
+		return validators.MessageMissing
+	case node.Null:
+		return validators.MessageNull
+	}
+	return ""
+}
@@ -0,0 +1,275 @@
+package engine
+
+import (
+	"encoding"
+	"encoding/json"
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+var (
+	jsonUnmarshalerType = reflect.TypeFor[json.Unmarshaler]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// TypeError is a value of a JSON document that cannot be decoded into the type
+// of its field.
+type TypeError struct {
+	Path Path
+	Type reflect.Type
+}
+
+// CheckTypes reports every value of the document recorded in presence that
+// json.Unmarshal cannot decode into t, where json.Unmarshal only reports the
+// first one. Paths are built like the paths of rule errors. Types decoding
+// themselves with UnmarshalJSON are not checked.
+func (e *Engine) CheckTypes(t reflect.Type, presence *Presence) []TypeError {
+	checker := typeChecker{options: e.current.Load().fieldOptions()}
+	checker.check(t, nil, presence)
+	return checker.errors
+}
+
+type typeChecker struct {
+	options fieldinfo.Options
+	errors  []TypeError
+}
+
+func (c *typeChecker) fail(path Path, t reflect.Type) {
+	c.errors = append(c.errors, TypeError{Path: path, Type: t})
+}
+
+func (c *typeChecker) check(t reflect.Type, path Path, node *Presence) {
+	if node == nil || node.Null {
+		return
+	}
+
+	for {
+		if implements(t, jsonUnmarshalerType) {
+			return
+		}
+		if implements(t, textUnmarshalerType) {
+			if _, ok := node.value.(string); !ok {
+				c.fail(path, t)
+			}
+			return
+		}
+		if t.Kind() != reflect.Pointer {
+			break
+		}
+		t = t.Elem()
+	}
+
+	switch {
+	case node.Fields != nil:
+		c.checkObject(t, path, node)
+	case node.Items != nil:
+		c.checkArray(t, path, node)
+	case !scalarFits(t, node.value):
+		c.fail(path, t)
+	}
+}
+
+func (c *typeChecker) checkObject(t reflect.Type, path Path, node *Presence) {
+	switch t.Kind() {
+	case reflect.Interface:
+		if t.NumMethod() > 0 {
+			c.fail(path, t)
+		}
+	case reflect.Struct:
+		// Each key is decoded into the field of the same key, or else the
+		// first field whose key matches ignoring case.
+		fields := jsonFields(t)
+		matches := make(map[int][]string, len(node.Fields))
+		for key := range node.Fields {
+			i := slices.IndexFunc(fields, func(field jsonField) bool { return field.key == key })
+			if i < 0 {
+				i = slices.IndexFunc(fields, func(field jsonField) bool { return strings.EqualFold(field.key, key) })
+			}
+			if i >= 0 {
+				matches[i] = append(matches[i], key)
+			}
+		}
+
+		for i, field := range fields {
+			if field.quoted {
+				continue
+			}
+			slices.Sort(matches[i])
+			for _, key := range matches[i] {
+				c.check(field.Type, path.FieldKey(c.options.FieldName(field.StructField), field.key), node.Fields[key])
+			}
+		}
+	case reflect.Map:
+		// Keys are decoded as text, as strings or as integers.
+		keyType := t.Key()
+		textKeys := implements(keyType, textUnmarshalerType) || keyType.Kind() == reflect.String
+		if !textKeys && !isInteger(keyType) {
+			c.fail(path, t)
+			return
+		}
+		for _, key := range slices.Sorted(maps.Keys(node.Fields)) {
+			elemPath := path.Field(key)
+			if !textKeys && !scalarFits(keyType, json.Number(key)) {
+				c.fail(elemPath, keyType)
+				continue
+			}
+			c.check(t.Elem(), elemPath, node.Fields[key])
+		}
+	default:
+		c.fail(path, t)
+	}
+}
+
+func (c *typeChecker) checkArray(t reflect.Type, path Path, node *Presence) {
+	switch t.Kind() {
+	case reflect.Interface:
+		if t.NumMethod() > 0 {
+			c.fail(path, t)
+		}
+	case reflect.Slice, reflect.Array:
+		for i, item := range node.Items {
+			if t.Kind() == reflect.Array && i >= t.Len() {
+				break
+			}
+			c.check(t.Elem(), path.Index(i), item)
+		}
+	default:
+		c.fail(path, t)
+	}
+}
+
+// scalarFits reports whether json.Unmarshal decodes a string, json.Number or
+// bool into a value of type t.
+func scalarFits(t reflect.Type, value any) bool {
+	if t.Kind() == reflect.Interface {
+		return t.NumMethod() == 0
+	}
+
+	switch value := value.(type) {
+	case string:
+		return t.Kind() == reflect.String || t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
+	case bool:
+		return t.Kind() == reflect.Bool
+	case json.Number:
+		var err error
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			_, err = strconv.ParseInt(value.String(), 10, t.Bits())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			_, err = strconv.ParseUint(value.String(), 10, t.Bits())
+		case reflect.Float32, reflect.Float64:
+			_, err = strconv.ParseFloat(value.String(), t.Bits())
+		case reflect.String:
+			return t == jsonNumberType
+		default:
+			return false
+		}
+		return err == nil
+	}
+	return false
+}
+
+func isInteger(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// implements reports whether values of type t, or pointers to them, implement
+// the interface iface, as json.Unmarshal calls methods through pointers.
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || reflect.PointerTo(t).Implements(iface)
+}
+
+// jsonField is a struct field decoded from the JSON key key.
+type jsonField struct {
+	reflect.StructField
+	key string
+	// index locates the field from t, through the embedded structs.
+	index []int
+	// quoted is set by the string option, decoding numbers and bools from strings.
+	quoted bool
+}
+
+// jsonFields lists the fields json.Unmarshal decodes into the struct type t,
+// including the fields of embedded structs. Shallower fields hide deeper ones
+// with the same key, and keys shared by fields of the same depth are dropped.
+// Fields are listed in declaration order.
+func jsonFields(t reflect.Type) []jsonField {
+	type embedded struct {
+		t     reflect.Type
+		index []int
+	}
+
+	var fields []jsonField
+	hidden := map[string]bool{}
+	visited := map[reflect.Type]bool{}
+
+	for level := []embedded{{t: t}}; len(level) > 0; {
+		var next []embedded
+		var found []jsonField
+		count := map[string]int{}
+
+		for _, parent := range level {
+			structType := parent.t
+			if visited[structType] {
+				continue
+			}
+			visited[structType] = true
+
+			for i := range structType.NumField() {
+				field := structType.Field(i)
+				index := append(slices.Clone(parent.index), i)
+				tag := field.Tag.Get(fieldinfo.JsonTag)
+				if tag == "-" {
+					continue
+				}
+				key, options, _ := strings.Cut(tag, ",")
+
+				fieldType := field.Type
+				if fieldType.Kind() == reflect.Pointer {
+					fieldType = fieldType.Elem()
+				}
+				if field.Anonymous && key == "" && fieldType.Kind() == reflect.Struct {
+					next = append(next, embedded{t: fieldType, index: index})
+					continue
+				}
+				if !field.IsExported() {
+					continue
+				}
+
+				if key == "" {
+					key = field.Name
+				}
+				found = append(found, jsonField{
+					StructField: field,
+					key:         key,
+					index:       index,
+					quoted:      slices.Contains(strings.Split(options, ","), "string"),
+				})
+				count[key]++
+			}
+		}
+
+		for _, field := range found {
+			if !hidden[field.key] && count[field.key] == 1 {
+				fields = append(fields, field)
+			}
+		}
+		for key := range count {
+			hidden[key] = true
+		}
+		level = next
+	}
+
+	slices.SortFunc(fields, func(a, b jsonField) int { return slices.Compare(a.index, b.index) })
+	return fields
+}
@@ -2,6 +2,14 @@ package fieldinfo
 
 import (
 	"reflect"
+	"regexp"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	durationType = reflect.TypeFor[time.Duration]()
+	nilType      = reflect.TypeFor[*any]()
 )
 
 // Info contains comprehensive information about a struct field for validation purposes.
@@ -17,6 +25,10 @@ type Info struct {
 	// Derived from the "json" struct tag, or falls back to the original field name.
 	JSONName string
 
+	// JSONKey is the key decoded into the field from JSON documents, which may
+	// differ from JSONName when errors name fields after another tag.
+	JSONKey string
+
 	// Type is the reflect.Type of the field after dereferencing any pointer types.
 	// For *string, this would be the string type.
 	Type reflect.Type
@@ -45,6 +57,10 @@ type Info struct {
 	// This contains the runtime value being validated.
 	Value reflect.Value
 
+	// Validators lists the validator names from the validation tag in declaration order.
+	// For validate:"required,min=5", this would be {"required", "min"}
+	Validators []string
+
 	// ValidatorStrs contains string arguments parsed from validation tags.
 	// For validate:"custom=hello", this would contain {"custom": "hello"}
 	ValidatorStrs map[string]string
@@ -57,6 +73,10 @@ type Info struct {
 	// IsRequired indicates whether the field has the "required" validator.
 	// This allows validators to skip validation on nil pointer fields that are not required.
 	IsRequired bool
+
+	// Pattern is the compiled regular expression of the "pattern" validator, if present.
+	// It is compiled once when the field information is extracted.
+	Pattern *regexp.Regexp
 }
 
 // GetValue returns the underlying reflect.Value of the field.
@@ -79,6 +99,29 @@ func (f Info) GetKind() reflect.Kind {
 	return f.OriginalKind
 }
 
+// WithValue returns a copy of the field information that describes value
+// instead of the declared field, keeping its name and validation arguments.
+// A pointer value is dereferenced like the declared field type is, and an
+// invalid value is described as a nil pointer.
+func (f Info) WithValue(value reflect.Value) Info {
+	if !value.IsValid() {
+		value = reflect.Zero(nilType)
+	}
+
+	valueType := value.Type()
+	f.OriginalKind = valueType.Kind()
+	f.IsPointer = f.OriginalKind == reflect.Pointer
+	if f.IsPointer {
+		valueType = valueType.Elem()
+	}
+
+	f.Value = value
+	f.Type = valueType
+	f.Kind = valueType.Kind()
+	f.TypeName = valueType.Name()
+	return f
+}
+
 // IsNil checks if the field value is nil.
 // It returns false if the field is not a pointer.
 // If the field is a pointer, it returns true if the value is nil.
@@ -114,22 +157,34 @@ func (f Info) IsSlice() bool {
 	return f.GetKind() == reflect.Slice
 }
 
+// IsMap checks if the field is a map.
+func (f Info) IsMap() bool {
+	return f.GetKind() == reflect.Map
+}
+
+// IsCollection checks if the field is a slice, an array or a map.
+func (f Info) IsCollection() bool {
+	kind := f.GetKind()
+	return kind == reflect.Slice || kind == reflect.Array || kind == reflect.Map
+}
+
 // Len returns the length of the field.
-// It returns -1 if the field is not a string or slice.
-// Otherwise, it returns the length of the string or slice.
+// It returns -1 if the field is not a string or collection.
+// Otherwise, it returns the length of the string, slice, array or map.
 func (f Info) Len() int {
 	if f.IsString() {
 		return len(f.GetValue().String())
 	}
-	if f.IsSlice() {
+	if f.IsCollection() {
 		return f.GetValue().Len()
 	}
 	return -1
 }
 
 // IsString checks if the field is a string.
+// Named types such as `type Slug string` are strings as well.
 func (f Info) IsString() bool {
-	return f.TypeName == "string"
+	return f.Kind == reflect.String
 }
 
 // String returns the string value of the field.
@@ -137,6 +192,26 @@ func (f Info) String() string {
 	return f.GetValue().String()
 }
 
+// IsTime checks if the field is a time.Time.
+func (f Info) IsTime() bool {
+	return f.Type == timeType
+}
+
+// Time returns the time.Time value of the field.
+func (f Info) Time() time.Time {
+	return f.GetValue().Interface().(time.Time)
+}
+
+// IsDuration checks if the field is a time.Duration.
+func (f Info) IsDuration() bool {
+	return f.Type == durationType
+}
+
+// Duration returns the time.Duration value of the field.
+func (f Info) Duration() time.Duration {
+	return time.Duration(f.GetValue().Int())
+}
+
 // GetArgumentStr returns the string value associated with the given validator name
 // from the Info's ValidatorStrs map. If the validator does not exist, it returns an empty string.
 func (f Info) GetArgumentStr(validatorName string) string {
@@ -0,0 +1,45 @@
+package fieldinfo
+
+import (
+	"reflect"
+	"strings"
+)
+
+// NameFunc returns the name reported in errors for a struct field, or an empty
+// string to use the Go field name.
+type NameFunc func(field reflect.StructField) string
+
+// TagName returns a NameFunc reading the name from the first part of the given
+// tag, as used by json, form, query, yaml and xml tags. A tag of "-" falls back
+// to the Go field name.
+func TagName(tag string) NameFunc {
+	return func(field reflect.StructField) string {
+		value := field.Tag.Get(tag)
+		if value == "-" {
+			return ""
+		}
+
+		name, _, _ := strings.Cut(value, ",")
+		return name
+	}
+}
+
+// JSONKey returns the key decoded into field from JSON documents: the name of
+// its json tag, or the Go field name.
+func JSONKey(field reflect.StructField) string {
+	if key := TagName(JsonTag)(field); key != "" {
+		return key
+	}
+	return field.Name
+}
+
+// ProtobufName is a NameFunc reading the name option of protobuf tags, e.g.
+// `protobuf:"bytes,1,opt,name=email,proto3"`.
+func ProtobufName(field reflect.StructField) string {
+	for _, option := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if name, ok := strings.CutPrefix(option, "name="); ok {
+			return name
+		}
+	}
+	return ""
+}
@@ -1,9 +1,14 @@
 package fieldinfo
 
 import (
+	"fmt"
 	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+
+	"github.com/renxzen/golidator/internal/patterns"
 )
 
 const (
@@ -11,7 +16,31 @@ const (
 	ValidateTag = "validate"
 )
 
-func ExtractInfo(structValue reflect.Value, fieldIndex int) Info {
+// Options controls how ExtractInfo reads the struct tags of a field.
+type Options struct {
+	// Tag is the key of the tag holding the rules.
+	Tag string
+	// Name returns the name reported in errors for the field.
+	Name NameFunc
+}
+
+// DefaultOptions reads rules from the validate tag and names from the json tag.
+func DefaultOptions() Options {
+	return Options{
+		Tag:  ValidateTag,
+		Name: TagName(JsonTag),
+	}
+}
+
+// FieldName returns the name of field in errors, falling back to the Go field name.
+func (o Options) FieldName(field reflect.StructField) string {
+	if name := o.Name(field); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+func ExtractInfo(structValue reflect.Value, fieldIndex int, options Options) (Info, error) {
 	structType := structValue.Type()
 	field := structType.Field(fieldIndex)
 	fieldValue := structValue.Field(fieldIndex)
@@ -27,49 +56,125 @@ func ExtractInfo(structValue reflect.Value, fieldIndex int) Info {
 		fieldKind = fieldType.Kind()
 	}
 
-	jsonName := field.Name
-	if jsonTag := field.Tag.Get(JsonTag); jsonTag != "" {
-		parts := strings.Split(jsonTag, ",")
-		if len(parts) > 0 && parts[0] != "" {
-			jsonName = parts[0]
-		}
+	jsonName := options.FieldName(field)
+
+	validateTag := MergeTags(field.Tag.Get(options.Tag), RegisteredRules(structType, fieldIndex))
+	info, err := ParseTag(validateTag)
+	if err != nil {
+		return Info{}, fmt.Errorf("field %s: %w", field.Name, err)
 	}
 
-	validateTag := field.Tag.Get(ValidateTag)
-	validatorArgs, validatorInts, isRequired := parseValidatorArgs(validateTag)
+	info.Index = fieldIndex
+	info.Name = field.Name
+	info.JSONName = jsonName
+	info.JSONKey = JSONKey(field)
+	info.Type = fieldType
+	info.Kind = fieldKind
+	info.TypeName = fieldType.Name()
+	info.IsPointer = isPointer
+	info.OriginalKind = originalKind
+	info.Value = fieldValue
+	return info, nil
+}
+
+// ParseTag parses the rules of a validation tag into an Info without value,
+// compiling the regular expression of the pattern rule.
+func ParseTag(validateTag string) (Info, error) {
+	validatorNames, validatorArgs, validatorInts, isRequired := parseValidatorArgs(validateTag)
+
+	var pattern *regexp.Regexp
+	if expr, exists := validatorArgs["pattern"]; exists {
+		re, err := patterns.Compile(expr)
+		if err != nil {
+			return Info{}, err
+		}
+		pattern = re
+	}
 
 	return Info{
-		Index:         fieldIndex,
-		Name:          field.Name,
-		JSONName:      jsonName,
-		Type:          fieldType,
-		Kind:          fieldKind,
-		TypeName:      fieldType.Name(),
 		ValidateTag:   validateTag,
-		IsPointer:     isPointer,
-		OriginalKind:  originalKind,
-		Value:         fieldValue,
+		Validators:    validatorNames,
 		ValidatorStrs: validatorArgs,
 		ValidatorInts: validatorInts,
 		IsRequired:    isRequired,
+		Pattern:       pattern,
+	}, nil
+}
+
+// SplitTag splits a validation tag into its rules. A comma inside an argument
+// can be escaped as "\,", e.g. `validate:"pattern=^[a-z]{2\,8}$"`. The options
+// of url rules may be separated by commas, e.g. `url=https,host_required`.
+func SplitTag(validateTag string) []string {
+	var rules []string
+	if !strings.Contains(validateTag, `\,`) {
+		rules = strings.Split(validateTag, ",")
+	} else {
+		var rule strings.Builder
+		for i := 0; i < len(validateTag); i++ {
+			switch {
+			case validateTag[i] == '\\' && i+1 < len(validateTag) && validateTag[i+1] == ',':
+				rule.WriteByte(',')
+				i++
+			case validateTag[i] == ',':
+				rules = append(rules, rule.String())
+				rule.Reset()
+			default:
+				rule.WriteByte(validateTag[i])
+			}
+		}
+		rules = append(rules, rule.String())
+	}
+
+	result := rules[:0]
+	for _, rule := range rules {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+
+		// Options following a url rule belong to its argument, joined by ";".
+		if n := len(result); n > 0 && isURLOption(rule) {
+			name, arg, _ := strings.Cut(result[n-1], "=")
+			if slices.Contains(urlRules, name) {
+				if arg != "" {
+					rule = arg + ";" + rule
+				}
+				result[n-1] = name + "=" + rule
+				continue
+			}
+		}
+		result = append(result, rule)
 	}
+	return result
 }
 
-func parseValidatorArgs(validateTag string) (map[string]string, map[string]int, bool) {
+// urlRules take options that can also be listed as rules after them, e.g.
+// `validate:"url=scheme:https|wss,host_required"`.
+var urlRules = []string{"url", "http_url", "uri"}
+
+func isURLOption(rule string) bool {
+	if rule == "host_required" {
+		return true
+	}
+	for _, prefix := range []string{"scheme:", "hosts:", "exclude_hosts:"} {
+		if strings.HasPrefix(rule, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseValidatorArgs(validateTag string) ([]string, map[string]string, map[string]int, bool) {
 	args := make(map[string]string)
 	ints := make(map[string]int)
 	isRequired := false
 	if validateTag == "" {
-		return args, ints, isRequired
+		return nil, args, ints, isRequired
 	}
 
-	validators := strings.SplitSeq(validateTag, ",")
-	for validator := range validators {
-		validator = strings.TrimSpace(validator)
-		if validator == "" {
-			continue
-		}
-
+	validators := SplitTag(validateTag)
+	names := make([]string, 0, len(validators))
+	for _, validator := range validators {
 		validatorName := validator
 		if idx := strings.IndexByte(validator, '='); idx != -1 {
 			validatorName = validator[:idx]
@@ -86,7 +191,8 @@ func parseValidatorArgs(validateTag string) (map[string]string, map[string]int,
 		if validatorName == "required" {
 			isRequired = true
 		}
+		names = append(names, validatorName)
 	}
 
-	return args, ints, isRequired
+	return names, args, ints, isRequired
 }
@@ -0,0 +1,80 @@
+package fieldinfo
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+type fieldKey struct {
+	Type  reflect.Type
+	Index int
+}
+
+var (
+	rulesMu      sync.RWMutex
+	rules        = map[fieldKey]string{}
+	rulesVersion atomic.Uint64
+)
+
+// RegisterRules adds rules to the field at index of the struct type t, as if
+// they were written in its tag. Registering again for a field merges with the
+// rules registered before.
+func RegisterRules(t reflect.Type, index int, fieldRules string) {
+	rulesMu.Lock()
+	defer rulesMu.Unlock()
+
+	key := fieldKey{Type: t, Index: index}
+	rules[key] = MergeTags(rules[key], fieldRules)
+	rulesVersion.Add(1)
+}
+
+// RegisteredRules returns the rules registered for the field at index of t.
+func RegisteredRules(t reflect.Type, index int) string {
+	rulesMu.RLock()
+	defer rulesMu.RUnlock()
+	return rules[fieldKey{Type: t, Index: index}]
+}
+
+// RulesVersion changes every time rules are registered, so that cached field
+// information can be recomputed.
+func RulesVersion() uint64 {
+	return rulesVersion.Load()
+}
+
+// MergeTags adds the rules of extra to tag. A rule present in both, such as
+// min, takes the argument from extra and keeps its position in tag.
+func MergeTags(tag, extra string) string {
+	if extra == "" {
+		return tag
+	}
+	if tag == "" {
+		return extra
+	}
+
+	merged := SplitTag(tag)
+	for _, rule := range SplitTag(extra) {
+		name, _, _ := strings.Cut(rule, "=")
+		i := indexRule(merged, name)
+		if i == -1 {
+			merged = append(merged, rule)
+			continue
+		}
+		merged[i] = rule
+	}
+
+	for i, rule := range merged {
+		merged[i] = strings.ReplaceAll(rule, ",", `\,`)
+	}
+	return strings.Join(merged, ",")
+}
+
+func indexRule(rules []string, name string) int {
+	for i, rule := range rules {
+		if ruleName, _, _ := strings.Cut(rule, "="); ruleName == name {
+			return i
+		}
+	}
+	return -1
+}
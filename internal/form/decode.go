@@ -0,0 +1,280 @@
+package form
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	Tag = "form"
+
+	MessageInvalidBool     = "must be a valid boolean"
+	MessageInvalidInt      = "must be a valid integer"
+	MessageInvalidUint     = "must be a valid positive integer"
+	MessageInvalidFloat    = "must be a valid number"
+	MessageInvalidTime     = "must be a valid RFC 3339 date or time"
+	MessageInvalidDuration = "must be a valid duration"
+	MessageInvalidValue    = "must be a valid value"
+	MessageInvalidString   = "must be a valid string"
+	MessageInvalidArray    = "must be a valid array"
+	MessageInvalidObject   = "must be a valid object"
+	MessageUnsupportedType = "unsupported type %s"
+)
+
+var (
+	timeType            = reflect.TypeFor[time.Time]()
+	durationType        = reflect.TypeFor[time.Duration]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// FieldError reports a value that could not be converted to the type of its field.
+type FieldError struct {
+	Name    string
+	Field   reflect.StructField
+	Value   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Name, e.Message)
+}
+
+// Errors lists the fields that could not be decoded.
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Name returns the key of a field in form values: the form tag, then the json
+// tag, then the Go field name. It returns false for fields tagged "-".
+func Name(field reflect.StructField) (string, bool) {
+	for _, tag := range []string{Tag, "json"} {
+		value, exists := field.Tag.Lookup(tag)
+		if !exists {
+			continue
+		}
+		if value == "-" {
+			return "", false
+		}
+		if name, _, _ := strings.Cut(value, ","); name != "" {
+			return name, true
+		}
+	}
+	return field.Name, true
+}
+
+// Decode sets the fields of the struct pointed to by dst from values. Every
+// value that cannot be converted is reported in the returned Errors, and keys
+// without a matching field are ignored.
+func Decode(values url.Values, dst any) error {
+	value := reflect.ValueOf(dst)
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("destination must be a non-nil pointer to a struct, got %T", dst)
+	}
+
+	var errs Errors
+	decodeStruct(values, value.Elem(), &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func decodeStruct(values url.Values, structValue reflect.Value, errs *Errors) {
+	structType := structValue.Type()
+	for i := range structType.NumField() {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldValue := structValue.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			decodeStruct(values, fieldValue, errs)
+			continue
+		}
+
+		name, ok := Name(field)
+		if !ok {
+			continue
+		}
+
+		inputs, exists := values[name]
+		if !exists {
+			continue
+		}
+
+		if message := setField(fieldValue, inputs); message != "" {
+			*errs = append(*errs, &FieldError{
+				Name:    name,
+				Field:   field,
+				Value:   strings.Join(inputs, ","),
+				Message: message,
+			})
+		}
+	}
+}
+
+// setField converts inputs to the type of field. Slices take every input, other
+// types the first one.
+func setField(field reflect.Value, inputs []string) string {
+	if field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.Slice && !isScalar(field.Type().Elem()) {
+		elem := reflect.New(field.Type().Elem())
+		if message := setField(elem.Elem(), inputs); message != "" {
+			return message
+		}
+		field.Set(elem)
+		return ""
+	}
+
+	if field.Kind() == reflect.Slice && !isScalar(field.Type()) {
+		slice := reflect.MakeSlice(field.Type(), 0, len(inputs))
+		for _, input := range inputs {
+			if input == "" {
+				continue
+			}
+			elem := reflect.New(field.Type().Elem()).Elem()
+			if message := setValue(elem, input); message != "" {
+				return message
+			}
+			slice = reflect.Append(slice, elem)
+		}
+		field.Set(slice)
+		return ""
+	}
+
+	if len(inputs) == 0 || inputs[0] == "" {
+		return ""
+	}
+	return setValue(field, inputs[0])
+}
+
+// isScalar reports whether values of t are decoded from a single input, as is
+// the case for byte slices and types implementing encoding.TextUnmarshaler.
+func isScalar(t reflect.Type) bool {
+	return reflect.PointerTo(t).Implements(textUnmarshalerType) || t.Elem().Kind() == reflect.Uint8
+}
+
+// setValue converts a single non-empty input to the type of value.
+func setValue(value reflect.Value, input string) string {
+	if value.Kind() == reflect.Pointer {
+		elem := reflect.New(value.Type().Elem())
+		if message := setValue(elem.Elem(), input); message != "" {
+			return message
+		}
+		value.Set(elem)
+		return ""
+	}
+
+	switch value.Type() {
+	case timeType:
+		t, err := parseTime(input)
+		if err != nil {
+			return MessageInvalidTime
+		}
+		value.Set(reflect.ValueOf(t))
+		return ""
+	case durationType:
+		d, err := time.ParseDuration(input)
+		if err != nil {
+			return MessageInvalidDuration
+		}
+		value.SetInt(int64(d))
+		return ""
+	}
+
+	if unmarshaler, ok := value.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		if unmarshaler.UnmarshalText([]byte(input)) != nil {
+			return MessageInvalidValue
+		}
+		return ""
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(input)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(input)
+		if err != nil {
+			return MessageInvalidBool
+		}
+		value.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(input, 10, value.Type().Bits())
+		if err != nil {
+			return MessageInvalidInt
+		}
+		value.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(input, 10, value.Type().Bits())
+		if err != nil {
+			return MessageInvalidUint
+		}
+		value.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(input, value.Type().Bits())
+		if err != nil {
+			return MessageInvalidFloat
+		}
+		value.SetFloat(n)
+	case reflect.Slice:
+		if value.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Sprintf(MessageUnsupportedType, value.Type())
+		}
+		value.SetBytes([]byte(input))
+	default:
+		return fmt.Sprintf(MessageUnsupportedType, value.Type())
+	}
+	return ""
+}
+
+// TypeMessage returns the message reported for a value that cannot be
+// converted to t, e.g. by encoding/json.
+func TypeMessage(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return MessageInvalidTime
+	case durationType:
+		return MessageInvalidDuration
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return MessageInvalidString
+	case reflect.Bool:
+		return MessageInvalidBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return MessageInvalidInt
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return MessageInvalidUint
+	case reflect.Float32, reflect.Float64:
+		return MessageInvalidFloat
+	case reflect.Slice, reflect.Array:
+		return MessageInvalidArray
+	case reflect.Struct, reflect.Map:
+		return MessageInvalidObject
+	}
+	return MessageInvalidValue
+}
+
+// parseTime accepts RFC 3339 timestamps and plain dates.
+func parseTime(input string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, input); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, input)
+}
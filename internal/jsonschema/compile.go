@@ -0,0 +1,304 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/renxzen/golidator/internal/engine"
+	"github.com/renxzen/golidator/internal/fieldinfo"
+	"github.com/renxzen/golidator/internal/validators"
+)
+
+// formats maps JSON Schema formats to the rules checking them. Other formats
+// are annotations and are not checked.
+var formats = map[string][2]string{
+	"email":     {"email", ""},
+	"uri":       {"uri", ""},
+	"ipv4":      {"ipv4", ""},
+	"ipv6":      {"ipv6", ""},
+	"hostname":  {"hostname", ""},
+	"uuid":      {"uuid", ""},
+	"date-time": {"datetime", time.RFC3339},
+	"date":      {"datetime", time.DateOnly},
+}
+
+// document is the subset of JSON Schema keywords that is supported.
+type document struct {
+	Type       json.RawMessage      `json:"type"`
+	Required   []string             `json:"required"`
+	Properties map[string]*document `json:"properties"`
+	Items      *document            `json:"items"`
+	Enum       []any                `json:"enum"`
+	Format     string               `json:"format"`
+	Pattern    *string              `json:"pattern"`
+	MinLength  *float64             `json:"minLength"`
+	MaxLength  *float64             `json:"maxLength"`
+	Minimum    *float64             `json:"minimum"`
+	Maximum    *float64             `json:"maximum"`
+	MinItems   *float64             `json:"minItems"`
+	MaxItems   *float64             `json:"maxItems"`
+}
+
+// Node validates a value against a compiled schema.
+type Node struct {
+	types      []string
+	required   []string
+	keys       []string
+	properties map[string]*Node
+	items      *Node
+	enum       []any
+
+	// stringRules, numberRules and arrayRules hold the rules that apply to
+	// values of the matching JSON type, as JSON Schema keywords do.
+	stringRules fieldinfo.Info
+	numberRules fieldinfo.Info
+	arrayRules  fieldinfo.Info
+}
+
+// Compile parses a JSON Schema document.
+func Compile(data []byte) (*Node, error) {
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	return compile(&doc, "#")
+}
+
+func compile(doc *document, location string) (*Node, error) {
+	node := &Node{
+		required:    doc.Required,
+		enum:        doc.Enum,
+		stringRules: newRules(),
+		numberRules: newRules(),
+		arrayRules:  newRules(),
+	}
+
+	if len(doc.Type) > 0 {
+		if err := json.Unmarshal(doc.Type, &node.types); err != nil {
+			var single string
+			if err := json.Unmarshal(doc.Type, &single); err != nil {
+				return nil, fmt.Errorf("%s: type must be a string or an array of strings", location)
+			}
+			node.types = []string{single}
+		}
+	}
+
+	bounds := []struct {
+		keyword string
+		value   *float64
+		rules   *fieldinfo.Info
+		rule    string
+	}{
+		{"minLength", doc.MinLength, &node.stringRules, "min"},
+		{"maxLength", doc.MaxLength, &node.stringRules, "max"},
+		{"minimum", doc.Minimum, &node.numberRules, "min"},
+		{"maximum", doc.Maximum, &node.numberRules, "max"},
+		{"minItems", doc.MinItems, &node.arrayRules, "min"},
+		{"maxItems", doc.MaxItems, &node.arrayRules, "max"},
+	}
+	for _, bound := range bounds {
+		if bound.value == nil {
+			continue
+		}
+
+		value := *bound.value
+		isInt := value == math.Trunc(value) && value >= 0 && value <= math.MaxInt32
+		if !isInt {
+			// minimum and maximum take any number, checked by min and max
+			// through their float argument. Lengths must be integers.
+			if bound.rules != &node.numberRules || math.IsInf(value, 0) || math.IsNaN(value) {
+				return nil, fmt.Errorf("%s: %s must be a non-negative integer", location, bound.keyword)
+			}
+			addRule(bound.rules, bound.rule, strconv.FormatFloat(value, 'f', -1, 64))
+			continue
+		}
+		addRule(bound.rules, bound.rule, strconv.Itoa(int(value)))
+		bound.rules.ValidatorInts[bound.rule] = int(value)
+	}
+
+	if doc.Pattern != nil {
+		re, err := regexp.Compile(*doc.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid pattern %q: %w", location, *doc.Pattern, err)
+		}
+		addRule(&node.stringRules, "pattern", *doc.Pattern)
+		node.stringRules.Pattern = re
+	}
+
+	if format, exists := formats[doc.Format]; exists {
+		addRule(&node.stringRules, format[0], format[1])
+	}
+
+	if len(doc.Properties) > 0 {
+		node.properties = make(map[string]*Node, len(doc.Properties))
+		for key, property := range doc.Properties {
+			child, err := compile(property, location+"/properties/"+key)
+			if err != nil {
+				return nil, err
+			}
+			node.keys = append(node.keys, key)
+			node.properties[key] = child
+		}
+		slices.Sort(node.keys)
+	}
+
+	if doc.Items != nil {
+		items, err := compile(doc.Items, location+"/items")
+		if err != nil {
+			return nil, err
+		}
+		node.items = items
+	}
+
+	return node, nil
+}
+
+func newRules() fieldinfo.Info {
+	return fieldinfo.Info{
+		ValidatorStrs: map[string]string{},
+		ValidatorInts: map[string]int{},
+	}
+}
+
+func addRule(rules *fieldinfo.Info, name, arg string) {
+	rules.Validators = append(rules.Validators, name)
+	if arg != "" {
+		rules.ValidatorStrs[name] = arg
+	}
+}
+
+// Validate checks value, decoded from JSON or built from maps and slices, and
+// returns the errors found under path.
+func (n *Node) Validate(value any, path engine.Path) []engine.ValidationError {
+	reflected := engine.NormalizeNumber(indirect(reflect.ValueOf(value)))
+
+	jsonType := typeOf(reflected)
+	if len(n.types) > 0 && !slices.ContainsFunc(n.types, func(t string) bool {
+		return t == jsonType || (t == "number" && jsonType == "integer")
+	}) {
+		return []engine.ValidationError{newError(path, fmt.Sprintf(validators.MessageInvalidJSONType, strings.Join(n.types, " or ")), "type")}
+	}
+
+	var results []engine.ValidationError
+	var messages, codes []string
+
+	if len(n.enum) > 0 && !slices.ContainsFunc(n.enum, func(member any) bool { return equal(member, reflected) }) {
+		members := make([]string, len(n.enum))
+		for i, member := range n.enum {
+			members[i] = fmt.Sprint(member)
+		}
+		messages = append(messages, fmt.Sprintf(validators.MessageNotOneOf, strings.Join(members, ", ")))
+		codes = append(codes, "oneof")
+	}
+
+	var rules fieldinfo.Info
+	switch jsonType {
+	case "string":
+		rules = n.stringRules
+	case "number", "integer":
+		rules = n.numberRules
+	case "array":
+		rules = n.arrayRules
+	}
+	if len(rules.Validators) > 0 {
+		ruleMessages, ruleCodes := engine.RunRules(rules.WithValue(reflected))
+		messages = append(messages, ruleMessages...)
+		codes = append(codes, ruleCodes...)
+	}
+
+	switch jsonType {
+	case "object":
+		// Keys are converted to the key type of the map, which may be a named
+		// string type.
+		keyType := reflected.Type().Key()
+		for _, key := range n.required {
+			if !reflected.MapIndex(reflect.ValueOf(key).Convert(keyType)).IsValid() {
+				results = append(results, newError(path.Field(key), validators.MessageMissing, "required"))
+			}
+		}
+		for _, key := range n.keys {
+			if elem := reflected.MapIndex(reflect.ValueOf(key).Convert(keyType)); elem.IsValid() {
+				results = append(results, n.properties[key].Validate(elem.Interface(), path.Field(key))...)
+			}
+		}
+	case "array":
+		if n.items != nil {
+			for i := range reflected.Len() {
+				results = append(results, n.items.Validate(reflected.Index(i).Interface(), path.Index(i))...)
+			}
+		}
+	}
+
+	if len(messages) > 0 {
+		results = append([]engine.ValidationError{{Errors: messages, Codes: codes, Path: path}}, results...)
+	}
+	return results
+}
+
+func newError(path engine.Path, message, code string) engine.ValidationError {
+	return engine.ValidationError{Errors: []string{message}, Codes: []string{code}, Path: path}
+}
+
+// indirect unwraps interfaces and pointers, returning an invalid value for nil.
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// typeOf returns the JSON type of value.
+func typeOf(value reflect.Value) string {
+	switch {
+	case !value.IsValid():
+		return "null"
+	case value.Kind() == reflect.String:
+		return "string"
+	case value.Kind() == reflect.Bool:
+		return "boolean"
+	case value.CanInt(), value.CanUint():
+		return "integer"
+	case value.CanFloat():
+		if f := value.Float(); f == math.Trunc(f) && !math.IsInf(f, 0) {
+			return "integer"
+		}
+		return "number"
+	case value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String:
+		return "object"
+	case value.Kind() == reflect.Slice || value.Kind() == reflect.Array:
+		return "array"
+	}
+	return "unknown"
+}
+
+// equal compares an enum member decoded from the schema with a value, numbers
+// by their value whatever their Go type.
+func equal(member any, value reflect.Value) bool {
+	if !value.IsValid() {
+		return member == nil
+	}
+
+	if n, ok := member.(float64); ok {
+		switch {
+		case value.CanInt():
+			return float64(value.Int()) == n
+		case value.CanUint():
+			return float64(value.Uint()) == n
+		case value.CanFloat():
+			return value.Float() == n
+		}
+		return false
+	}
+
+	return value.CanInterface() && reflect.DeepEqual(member, value.Interface())
+}
@@ -0,0 +1,28 @@
+package patterns
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Registry holds the named patterns that tags can refer to as "pattern=@name".
+var Registry = map[string]*regexp.Regexp{}
+
+// Compile returns the regular expression for a pattern argument.
+// Arguments starting with "@" are looked up in the Registry instead of compiled.
+func Compile(expr string) (*regexp.Regexp, error) {
+	if name, ok := strings.CutPrefix(expr, "@"); ok {
+		re, exists := Registry[name]
+		if !exists {
+			return nil, fmt.Errorf("unknown pattern %q", name)
+		}
+		return re, nil
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", expr, err)
+	}
+	return re, nil
+}
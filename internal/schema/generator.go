@@ -0,0 +1,228 @@
+package schema
+
+import (
+	"encoding"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/renxzen/golidator/internal/cache"
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+var (
+	timeType            = reflect.TypeFor[time.Time]()
+	durationType        = reflect.TypeFor[time.Duration]()
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	invalidNameCharsRgx = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+)
+
+// Generator builds schemas for Go types. Named struct types are collected in
+// Defs and referenced with $ref, which also supports recursive types.
+type Generator struct {
+	// TypeInfo returns the cached field information of a struct type.
+	TypeInfo func(t reflect.Type) (*cache.TypeInfo, error)
+	// RefPrefix is prepended to the names of Defs in $ref, e.g. "#/$defs/".
+	RefPrefix string
+	// Defs holds the schemas of the named struct types met so far.
+	Defs map[string]*Schema
+	// DescriptionTag, when set, names the tag holding the description of a field.
+	DescriptionTag string
+	// ExampleTag, when set, names the tag holding an example value of a field.
+	// Slices take comma separated values.
+	ExampleTag string
+
+	names map[reflect.Type]string
+	types map[string]reflect.Type
+}
+
+// Type returns the schema of t, referencing named struct types in Defs.
+func (g *Generator) Type(t reflect.Type) (*Schema, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	case durationType:
+		return &Schema{Type: "integer"}, nil
+	}
+
+	if t.Kind() != reflect.String && (t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType)) {
+		return &Schema{Type: "string"}, nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		zero := 0.0
+		return &Schema{Type: "integer", Minimum: &zero}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", ContentEncoding: "base64"}, nil
+		}
+		items, err := g.Type(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		values, err := g.Type(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.Object(t)
+		}
+		return g.ref(t)
+	}
+
+	return &Schema{}, nil
+}
+
+// ref returns a $ref to the schema of the named struct type t, adding it to
+// Defs the first time.
+func (g *Generator) ref(t reflect.Type) (*Schema, error) {
+	if g.names == nil {
+		g.names = map[reflect.Type]string{}
+		g.types = map[string]reflect.Type{}
+	}
+	if g.Defs == nil {
+		g.Defs = map[string]*Schema{}
+	}
+
+	name, exists := g.names[t]
+	if !exists {
+		name = g.defName(t)
+		g.names[t] = name
+		g.types[name] = t
+
+		object, err := g.Object(t)
+		if err != nil {
+			return nil, err
+		}
+		g.Defs[name] = object
+	}
+
+	return &Schema{Ref: g.RefPrefix + name}, nil
+}
+
+// defName names t in Defs after its type name, qualified by its package when
+// another type already uses the name.
+func (g *Generator) defName(t reflect.Type) string {
+	name := invalidNameCharsRgx.ReplaceAllString(t.Name(), "_")
+	if _, taken := g.types[name]; !taken {
+		return name
+	}
+
+	qualified := path.Base(t.PkgPath()) + "." + name
+	name = qualified
+	for i := 2; ; i++ {
+		if _, taken := g.types[name]; !taken {
+			return name
+		}
+		name = fmt.Sprintf("%s%d", qualified, i)
+	}
+}
+
+// Object returns the inline object schema of the struct type t, with a
+// property per exported field named after its json tag and the keywords of
+// its rules.
+func (g *Generator) Object(t reflect.Type) (*Schema, error) {
+	typeInfo, err := g.TypeInfo(t)
+	if err != nil {
+		return nil, err
+	}
+
+	object := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, info := range typeInfo.Fields {
+		field := t.Field(info.Index)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := fieldinfo.TagName(fieldinfo.JsonTag)(field)
+		if field.Tag.Get(fieldinfo.JsonTag) == "-" {
+			continue
+		}
+
+		if name == "" && field.Anonymous && info.Kind == reflect.Struct {
+			embedded, err := g.Object(info.Type)
+			if err != nil {
+				return nil, err
+			}
+			for key, property := range embedded.Properties {
+				object.Properties[key] = property
+			}
+			object.Required = append(object.Required, embedded.Required...)
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		property, err := g.Type(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		if g.DescriptionTag != "" {
+			property.Description = field.Tag.Get(g.DescriptionTag)
+		}
+		if example, exists := field.Tag.Lookup(g.ExampleTag); exists && g.ExampleTag != "" {
+			property.Examples = []any{exampleValue(field.Type, example)}
+		}
+
+		for _, rule := range info.Validators {
+			if rule == "required" {
+				object.Required = append(object.Required, name)
+			}
+			if ruleFunc, exists := Rules[rule]; exists {
+				ruleFunc(property, info)
+			}
+		}
+
+		object.Properties[name] = property
+	}
+
+	return object, nil
+}
+
+// exampleValue converts an example tag to the JSON type of t.
+func exampleValue(t reflect.Type, example string) any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		if b, err := strconv.ParseBool(example); err == nil {
+			return b
+		}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return example
+		}
+		values := []any{}
+		for _, value := range strings.Split(example, ",") {
+			values = append(values, exampleValue(t.Elem(), strings.TrimSpace(value)))
+		}
+		return values
+	}
+	return enumValue(t.Kind(), example)
+}
@@ -0,0 +1,210 @@
+package schema
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+// RuleFunc adds the keywords of a rule to the schema of a field.
+type RuleFunc func(schema *Schema, field fieldinfo.Info)
+
+// Rules maps rule names to the keywords they contribute. Rules without an
+// entry are left out of the schema.
+var Rules = map[string]RuleFunc{
+	"notblank": notBlank,
+	"notempty": notEmpty,
+	"min":      bound("min", false),
+	"max":      bound("max", true),
+	"len":      length,
+	"unique":   uniqueItems,
+	"oneof":    oneOf,
+	"pattern":  pattern,
+	"numeric":  withPattern("^[0-9]+$"),
+	"alpha":    withPattern("^[a-zA-Z]+$"),
+	"alphanum": withPattern("^[a-zA-Z0-9]+$"),
+	"e164":     withPattern(`^\+[1-9][0-9]{1,14}$`),
+	"hexcolor": withPattern("^#([0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$"),
+
+	"email":    withFormat("email"),
+	"url":      withFormat("uri"),
+	"http_url": withFormat("uri"),
+	"uri":      withFormat("uri"),
+	"ipv4":     withFormat("ipv4"),
+	"ipv6":     withFormat("ipv6"),
+	"hostname": withFormat("hostname"),
+	"fqdn":     withFormat("hostname"),
+	"uuid":     withFormat("uuid"),
+	"uuid1":    withFormat("uuid"),
+	"uuid3":    withFormat("uuid"),
+	"uuid4":    withFormat("uuid"),
+	"uuid5":    withFormat("uuid"),
+	"uuid6":    withFormat("uuid"),
+	"uuid7":    withFormat("uuid"),
+	"uuid8":    withFormat("uuid"),
+
+	"base64": func(schema *Schema, field fieldinfo.Info) {
+		schema.ContentEncoding = "base64"
+	},
+}
+
+func withFormat(format string) RuleFunc {
+	return func(schema *Schema, field fieldinfo.Info) {
+		schema.Format = format
+	}
+}
+
+func withPattern(expr string) RuleFunc {
+	return func(schema *Schema, field fieldinfo.Info) {
+		setPattern(schema, expr)
+	}
+}
+
+func pattern(schema *Schema, field fieldinfo.Info) {
+	if field.Pattern != nil {
+		setPattern(schema, field.Pattern.String())
+	}
+}
+
+func notBlank(schema *Schema, field fieldinfo.Info) {
+	if field.Kind == reflect.String {
+		setMin(&schema.MinLength, 1)
+		setPattern(schema, `\S`)
+	}
+}
+
+// setPattern adds a pattern to the schema. A schema holds a single pattern, so
+// the patterns of further rules are required through allOf.
+func setPattern(schema *Schema, expr string) {
+	if schema.Pattern == "" {
+		schema.Pattern = expr
+		return
+	}
+	if schema.Pattern == expr || slices.ContainsFunc(schema.AllOf, func(s *Schema) bool { return s.Pattern == expr }) {
+		return
+	}
+	schema.AllOf = append(schema.AllOf, &Schema{Pattern: expr})
+}
+
+func notEmpty(schema *Schema, field fieldinfo.Info) {
+	switch field.Kind {
+	case reflect.String:
+		setMin(&schema.MinLength, 1)
+	case reflect.Slice, reflect.Array:
+		setMin(&schema.MinItems, 1)
+	case reflect.Map:
+		setMin(&schema.MinProperties, 1)
+	}
+}
+
+// setMin raises a lower bound, keeping the stricter of two rules.
+func setMin(keyword **int, n int) {
+	if *keyword == nil || **keyword < n {
+		*keyword = &n
+	}
+}
+
+// bound maps min and max to the length, item count or numeric bound keyword
+// matching the kind of the field.
+func bound(name string, upper bool) RuleFunc {
+	return func(schema *Schema, field fieldinfo.Info) {
+		n, exists := field.GetArgumentInt(name)
+		if !exists || field.IsDuration() {
+			return
+		}
+
+		var keyword **int
+		switch field.Kind {
+		case reflect.String:
+			keyword = pick(upper, &schema.MinLength, &schema.MaxLength)
+		case reflect.Slice, reflect.Array:
+			keyword = pick(upper, &schema.MinItems, &schema.MaxItems)
+		case reflect.Map:
+			keyword = pick(upper, &schema.MinProperties, &schema.MaxProperties)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			value := float64(n)
+			if upper {
+				schema.Maximum = &value
+			} else {
+				schema.Minimum = &value
+			}
+			return
+		default:
+			return
+		}
+		*keyword = &n
+	}
+}
+
+func pick(upper bool, lower, higher **int) **int {
+	if upper {
+		return higher
+	}
+	return lower
+}
+
+func length(schema *Schema, field fieldinfo.Info) {
+	n, exists := field.GetArgumentInt("len")
+	if !exists {
+		return
+	}
+
+	switch field.Kind {
+	case reflect.String:
+		schema.MinLength, schema.MaxLength = &n, &n
+	case reflect.Slice, reflect.Array:
+		schema.MinItems, schema.MaxItems = &n, &n
+	}
+}
+
+func uniqueItems(schema *Schema, field fieldinfo.Info) {
+	if field.GetArgumentStr("unique") == "" {
+		schema.UniqueItems = true
+	}
+}
+
+// oneOf lists the values of the tag, or the members returned by the Values
+// method of the field type, as an enum.
+func oneOf(schema *Schema, field fieldinfo.Info) {
+	if arg := field.GetArgumentStr("oneof"); arg != "" {
+		for _, value := range strings.Fields(arg) {
+			schema.Enum = append(schema.Enum, enumValue(field.Kind, value))
+		}
+		return
+	}
+
+	method := reflect.New(field.Type).MethodByName("Values")
+	if !method.IsValid() || method.Type().NumIn() != 0 || method.Type().NumOut() != 1 ||
+		method.Type().Out(0) != reflect.SliceOf(field.Type) {
+		return
+	}
+
+	members := method.Call(nil)[0]
+	for i := range members.Len() {
+		schema.Enum = append(schema.Enum, members.Index(i).Interface())
+	}
+}
+
+// enumValue converts a oneof argument to the JSON type of kind.
+func enumValue(kind reflect.Kind, value string) any {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return n
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseUint(value, 10, 64); err == nil {
+			return n
+		}
+	case reflect.Float32, reflect.Float64:
+		if n, err := strconv.ParseFloat(value, 64); err == nil {
+			return n
+		}
+	}
+	return value
+}
@@ -0,0 +1,90 @@
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+)
+
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema (draft 2020-12) document or subschema.
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	UniqueItems          bool               `json:"uniqueItems,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AllOf                []*Schema          `json:"allOf,omitempty"`
+	Examples             []any              `json:"examples,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+
+	// Extra holds keywords without a field, e.g. "x-" extensions or keywords
+	// contributed by custom rules. They are written after the other keywords.
+	Extra map[string]any `json:"-"`
+}
+
+// SetKeyword sets a keyword in Extra.
+func (s *Schema) SetKeyword(name string, value any) {
+	if s.Extra == nil {
+		s.Extra = map[string]any{}
+	}
+	s.Extra[name] = value
+}
+
+// plain has the fields of Schema without its MarshalJSON method.
+type plain Schema
+
+// MarshalJSON writes the keywords of the schema followed by Extra in key order.
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal((*plain)(s))
+	if err != nil || len(s.Extra) == 0 {
+		return data, err
+	}
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	separator := len(data) > 2
+
+	keys := make([]string, 0, len(s.Extra))
+	for key := range s.Extra {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		value, err := json.Marshal(s.Extra[key])
+		if err != nil {
+			return nil, err
+		}
+		name, _ := json.Marshal(key)
+
+		if separator {
+			buf.WriteByte(',')
+		}
+		separator = true
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,108 @@
+package validators
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+// elements returns the elements of a slice or array, or the values of a map.
+func elements(value reflect.Value) []reflect.Value {
+	if value.Kind() == reflect.Map {
+		result := make([]reflect.Value, 0, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			result = append(result, iter.Value())
+		}
+		return result
+	}
+
+	result := make([]reflect.Value, value.Len())
+	for i := range result {
+		result[i] = value.Index(i)
+	}
+	return result
+}
+
+// indirect dereferences pointers, returning an invalid value for nil pointers.
+func indirect(value reflect.Value) reflect.Value {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// Unique validates that the elements of a slice, array or map are unique.
+// For collections of structs, `validate:"unique=Field"` compares that field instead.
+func Unique(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if !field.IsCollection() {
+		return MessageNotArrayType
+	}
+
+	keyField := field.GetArgumentStr("unique")
+	seen := make(map[any]struct{}, field.Len())
+	for _, elem := range elements(field.GetValue()) {
+		elem = indirect(elem)
+		if keyField != "" && elem.IsValid() {
+			if elem.Kind() != reflect.Struct {
+				return MessageNotStructSliceType
+			}
+			elem = elem.FieldByName(keyField)
+			if !elem.IsValid() {
+				return fmt.Sprintf(MessageUnknownUniqueField, keyField)
+			}
+			elem = indirect(elem)
+		}
+
+		var key any
+		if elem.IsValid() {
+			if !elem.CanInterface() || !elem.Type().Comparable() {
+				return MessageNotComparableType
+			}
+			key = elem.Interface()
+		}
+
+		if _, exists := seen[key]; exists {
+			return MessageNotUnique
+		}
+		seen[key] = struct{}{}
+	}
+
+	return ""
+}
+
+// ContainsElem validates that a slice, array or map holds an element equal to
+// the argument, e.g. `validate:"containselem=admin"`.
+func ContainsElem(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if !field.IsCollection() {
+		return MessageNotArrayType
+	}
+
+	arg := field.GetArgumentStr("containselem")
+	for _, elem := range elements(field.GetValue()) {
+		elem = indirect(elem)
+		if !elem.IsValid() {
+			continue
+		}
+		if !isComparableKind(elem) {
+			return MessageNotStrNumSliceType
+		}
+		if equalsArg(elem, arg) {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf(MessageNotContainsElem, arg)
+}
@@ -0,0 +1,162 @@
+package validators
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+var (
+	semverRegex    = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+	hexColorRegex  = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	mediaTypeRegex = regexp.MustCompile(`^([a-zA-Z0-9!#$&^_.+-]+/[a-zA-Z0-9!#$&^_.+-]+)?(;[a-zA-Z0-9!#$&^_.+-]+=[^;,]+)*$`)
+)
+
+// checkDecodedSize validates the decoded length against the optional maximum
+// given as the rule argument, e.g. `validate:"base64=1024"`.
+func checkDecodedSize(field fieldinfo.Info, validatorName string, size int) string {
+	maxSize, exists := field.GetArgumentInt(validatorName)
+	if exists && size > maxSize {
+		return fmt.Sprintf(MessageDecodedTooLarge, maxSize)
+	}
+	return ""
+}
+
+// base64Validator returns the validator for the rule name using encoding.
+func base64Validator(name string, encoding *base64.Encoding, message string) ValidatorFunc {
+	return func(field fieldinfo.Info) string {
+		return checkString(field, func(str string) string {
+			decoded, err := encoding.DecodeString(str)
+			if err != nil {
+				return message
+			}
+			return checkDecodedSize(field, name, len(decoded))
+		})
+	}
+}
+
+func Hexadecimal(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		str = strings.TrimPrefix(strings.TrimPrefix(str, "0x"), "0X")
+		if str == "" {
+			return MessageInvalidHexadecimal
+		}
+		for i := range len(str) {
+			c := str[i]
+			if (c < '0' || c > '9') && (c < 'a' || c > 'f') && (c < 'A' || c > 'F') {
+				return MessageInvalidHexadecimal
+			}
+		}
+		return checkDecodedSize(field, "hexadecimal", (len(str)+1)/2)
+	})
+}
+
+// JSON validates that a string or byte slice, such as json.RawMessage, holds a
+// valid JSON document.
+func JSON(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	value := field.GetValue()
+	if value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8 {
+		if value.Len() > 0 && !json.Valid(value.Bytes()) {
+			return MessageInvalidJSON
+		}
+		return ""
+	}
+
+	return checkString(field, func(str string) string {
+		if !json.Valid([]byte(str)) {
+			return MessageInvalidJSON
+		}
+		return ""
+	})
+}
+
+// Semver validates a semantic version as defined by semver.org, e.g. "1.2.3-rc.1+build.5".
+func Semver(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		if !semverRegex.MatchString(str) {
+			return MessageInvalidSemver
+		}
+		return ""
+	})
+}
+
+// HexColor validates a CSS hex color such as "#fff" or "#1a2b3c80".
+func HexColor(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		if !hexColorRegex.MatchString(str) {
+			return MessageInvalidHexColor
+		}
+		return ""
+	})
+}
+
+// isJWTSegment reports whether segment is base64url encoded JSON object.
+func isJWTSegment(segment string) bool {
+	decoded, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return false
+	}
+
+	var object map[string]any
+	return json.Unmarshal(decoded, &object) == nil && object != nil
+}
+
+// JWT validates the structure of a JSON Web Token: a header and payload that
+// decode to JSON objects and a base64url signature. The signature is not verified.
+func JWT(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		parts := strings.Split(str, ".")
+		if len(parts) != 3 || !isJWTSegment(parts[0]) || !isJWTSegment(parts[1]) {
+			return MessageInvalidJWT
+		}
+		if _, err := base64.RawURLEncoding.DecodeString(parts[2]); err != nil {
+			return MessageInvalidJWT
+		}
+		return ""
+	})
+}
+
+// DataURI validates an RFC 2397 data URI such as "data:image/png;base64,iVBOR...".
+func DataURI(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		rest, ok := strings.CutPrefix(str, "data:")
+		if !ok {
+			return MessageInvalidDataURI
+		}
+
+		header, data, ok := strings.Cut(rest, ",")
+		if !ok {
+			return MessageInvalidDataURI
+		}
+
+		header, isBase64 := strings.CutSuffix(header, ";base64")
+		if !mediaTypeRegex.MatchString(header) {
+			return MessageInvalidDataURI
+		}
+
+		var decoded []byte
+		var err error
+		if isBase64 {
+			decoded, err = base64.StdEncoding.DecodeString(data)
+		} else {
+			var unescaped string
+			unescaped, err = url.PathUnescape(data)
+			decoded = []byte(unescaped)
+		}
+		if err != nil {
+			return MessageInvalidDataURI
+		}
+
+		return checkDecodedSize(field, "datauri", len(decoded))
+	})
+}
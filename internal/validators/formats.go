@@ -0,0 +1,237 @@
+package validators
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+var (
+	bicRegex  = regexp.MustCompile(`^[A-Z]{4}([A-Z]{2})[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+	e164Regex = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+	// separatorReplacer removes the spaces and hyphens used to group digits
+	// in card numbers, IBANs and ISBNs.
+	separatorReplacer = strings.NewReplacer(" ", "", "-", "")
+)
+
+// isLuhn reports whether a string of digits passes the Luhn checksum.
+func isLuhn(digits string) bool {
+	if len(digits) < 2 {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		c := digits[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+
+		n := int(c - '0')
+		if double {
+			n *= 2
+			if n > 9 {
+				n -= 9
+			}
+		}
+		sum += n
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// Luhn validates a string of digits, or an unsigned integer, against the Luhn checksum.
+func Luhn(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if value := field.GetValue(); value.CanUint() {
+		if !isLuhn(strconv.FormatUint(value.Uint(), 10)) {
+			return MessageInvalidLuhn
+		}
+		return ""
+	}
+
+	return checkString(field, func(str string) string {
+		if !isLuhn(str) {
+			return MessageInvalidLuhn
+		}
+		return ""
+	})
+}
+
+// CreditCard validates a 12 to 19 digit card number with a valid Luhn checksum.
+// Digits may be grouped with spaces or hyphens.
+func CreditCard(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		digits := separatorReplacer.Replace(str)
+		if len(digits) < 12 || len(digits) > 19 || !isLuhn(digits) {
+			return MessageInvalidCreditCard
+		}
+		return ""
+	})
+}
+
+// isIBAN checks the country specific length and the ISO 7064 mod 97 checksum.
+func isIBAN(iban string) bool {
+	if len(iban) < 4 {
+		return false
+	}
+
+	length, exists := ibanLengths[iban[:2]]
+	if !exists || len(iban) != length || iban[2] < '0' || iban[2] > '9' || iban[3] < '0' || iban[3] > '9' {
+		return false
+	}
+
+	remainder := 0
+	rearranged := iban[4:] + iban[:4]
+	for i := range len(rearranged) {
+		c := rearranged[i]
+		switch {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+
+	return remainder == 1
+}
+
+// IBAN validates an international bank account number. The printed format with
+// spaces between groups is accepted.
+func IBAN(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		if !isIBAN(strings.ReplaceAll(str, " ", "")) {
+			return MessageInvalidIBAN
+		}
+		return ""
+	})
+}
+
+// BIC validates an 8 or 11 character ISO 9362 business identifier code.
+func BIC(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		match := bicRegex.FindStringSubmatch(str)
+		if match == nil {
+			return MessageInvalidBIC
+		}
+
+		// Kosovo uses XK which is not assigned in ISO 3166
+		if _, exists := countryCodes[match[1]]; !exists && match[1] != "XK" {
+			return MessageInvalidBIC
+		}
+
+		return ""
+	})
+}
+
+func isISBN10(isbn string) bool {
+	if len(isbn) != 10 {
+		return false
+	}
+
+	sum := 0
+	for i := range 10 {
+		c := isbn[i]
+		switch {
+		case c >= '0' && c <= '9':
+			sum += int(c-'0') * (10 - i)
+		case c == 'X' && i == 9:
+			sum += 10
+		default:
+			return false
+		}
+	}
+
+	return sum%11 == 0
+}
+
+func isISBN13(isbn string) bool {
+	if len(isbn) != 13 || (!strings.HasPrefix(isbn, "978") && !strings.HasPrefix(isbn, "979")) {
+		return false
+	}
+
+	sum := 0
+	for i := range 13 {
+		c := isbn[i]
+		if c < '0' || c > '9' {
+			return false
+		}
+
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+		sum += int(c-'0') * weight
+	}
+
+	return sum%10 == 0
+}
+
+// ISBN validates an ISBN-10 or ISBN-13, optionally grouped with spaces or hyphens.
+func ISBN(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		isbn := separatorReplacer.Replace(str)
+		if !isISBN10(isbn) && !isISBN13(isbn) {
+			return MessageInvalidISBN
+		}
+		return ""
+	})
+}
+
+func ISBN10(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		if !isISBN10(separatorReplacer.Replace(str)) {
+			return MessageInvalidISBN10
+		}
+		return ""
+	})
+}
+
+func ISBN13(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		if !isISBN13(separatorReplacer.Replace(str)) {
+			return MessageInvalidISBN13
+		}
+		return ""
+	})
+}
+
+// E164 validates a phone number in E.164 format, e.g. "+14155552671".
+func E164(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		if !e164Regex.MatchString(str) {
+			return MessageInvalidE164
+		}
+		return ""
+	})
+}
+
+// CountryCode validates an uppercase ISO 3166-1 alpha-2 country code.
+func CountryCode(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		if _, exists := countryCodes[str]; !exists {
+			return MessageInvalidCountryCode
+		}
+		return ""
+	})
+}
+
+// CurrencyCode validates an uppercase ISO 4217 currency code.
+func CurrencyCode(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		if _, exists := currencyCodes[str]; !exists {
+			return MessageInvalidCurrencyCode
+		}
+		return ""
+	})
+}
@@ -0,0 +1,218 @@
+package validators
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+const (
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZabcdefghjkmnpqrstvwxyz"
+	base62Alphabet    = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	maxKSUID          = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+)
+
+// idOptions holds the format constraints parsed from an identifier argument.
+// Options are separated by ";" like the url options:
+//
+//	validate:"uuid=case:lower"
+//	validate:"uuid4=case:upper;hyphens:optional"
+//
+// case is one of any (default), lower or upper. hyphens is one of required
+// (default), none or optional and only applies to UUIDs.
+type idOptions struct {
+	letterCase string
+	hyphens    string
+}
+
+var idOptionsCache sync.Map
+
+func getIDOptions(arg string) *idOptions {
+	if cached, ok := idOptionsCache.Load(arg); ok {
+		return cached.(*idOptions)
+	}
+
+	opts := &idOptions{letterCase: "any", hyphens: "required"}
+	for option := range strings.SplitSeq(arg, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(option), ":")
+		switch key {
+		case "case":
+			opts.letterCase = value
+		case "hyphens":
+			opts.hyphens = value
+		}
+	}
+
+	cached, _ := idOptionsCache.LoadOrStore(arg, opts)
+	return cached.(*idOptions)
+}
+
+func (o *idOptions) checkCase(str string) bool {
+	switch o.letterCase {
+	case "lower":
+		return str == strings.ToLower(str)
+	case "upper":
+		return str == strings.ToUpper(str)
+	}
+	return true
+}
+
+// idBytes returns a copy of the field value when it is a [size]byte array.
+func idBytes(field fieldinfo.Info, size int) ([]byte, bool) {
+	value := field.GetValue()
+	if value.Kind() != reflect.Array || value.Len() != size || value.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, false
+	}
+
+	id := make([]byte, size)
+	for i := range id {
+		id[i] = byte(value.Index(i).Uint())
+	}
+	return id, true
+}
+
+func isZeroID(id []byte) bool {
+	for _, b := range id {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func parseUUID(str string, opts *idOptions) ([]byte, bool) {
+	if !opts.checkCase(str) {
+		return nil, false
+	}
+
+	switch len(str) {
+	case 36:
+		if opts.hyphens == "none" || str[8] != '-' || str[13] != '-' || str[18] != '-' || str[23] != '-' {
+			return nil, false
+		}
+		str = str[:8] + str[9:13] + str[14:18] + str[19:23] + str[24:]
+	case 32:
+		if opts.hyphens == "required" {
+			return nil, false
+		}
+	default:
+		return nil, false
+	}
+
+	id, err := hex.DecodeString(str)
+	return id, err == nil
+}
+
+// hasUUIDVersion reports whether id carries the given version and the
+// RFC 9562 variant. A version of 0 accepts any UUID.
+func hasUUIDVersion(id []byte, version byte) bool {
+	if version == 0 {
+		return true
+	}
+	return id[6]>>4 == version && id[8]&0xc0 == 0x80
+}
+
+// uuidValidator returns the validator for the rule name, restricted to a UUID
+// version unless version is 0. It accepts strings and [16]byte arrays.
+func uuidValidator(name string, version byte) ValidatorFunc {
+	message := MessageInvalidUUID
+	if version != 0 {
+		message = fmt.Sprintf(MessageInvalidUUIDVersion, version)
+	}
+
+	return func(field fieldinfo.Info) string {
+		if field.IsNil() {
+			return ""
+		}
+
+		if id, ok := idBytes(field, 16); ok {
+			if !isZeroID(id) && !hasUUIDVersion(id, version) {
+				return message
+			}
+			return ""
+		}
+
+		if !field.IsString() {
+			return MessageNotStrBytesType
+		}
+
+		str := field.String()
+		if str == "" {
+			return ""
+		}
+
+		id, ok := parseUUID(str, getIDOptions(field.GetArgumentStr(name)))
+		if !ok || !hasUUIDVersion(id, version) {
+			return message
+		}
+
+		return ""
+	}
+}
+
+// ULID validates a 26 character Crockford base32 ULID.
+func ULID(field fieldinfo.Info) string {
+	opts := getIDOptions(field.GetArgumentStr("ulid"))
+	return checkString(field, func(str string) string {
+		if len(str) != 26 || str[0] > '7' || !opts.checkCase(str) {
+			return MessageInvalidULID
+		}
+		for i := range len(str) {
+			if strings.IndexByte(crockfordAlphabet, str[i]) == -1 {
+				return MessageInvalidULID
+			}
+		}
+		return ""
+	})
+}
+
+// KSUID validates a 27 character base62 KSUID.
+func KSUID(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		// the base62 alphabet is in ascii order so the bound can be compared as a string
+		if len(str) != 27 || str > maxKSUID {
+			return MessageInvalidKSUID
+		}
+		for i := range len(str) {
+			if strings.IndexByte(base62Alphabet, str[i]) == -1 {
+				return MessageInvalidKSUID
+			}
+		}
+		return ""
+	})
+}
+
+// ObjectID validates a MongoDB ObjectID as a 24 character hex string or a [12]byte array.
+func ObjectID(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if _, ok := idBytes(field, 12); ok {
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStrBytesType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	opts := getIDOptions(field.GetArgumentStr("objectid"))
+	if len(str) != 24 || !opts.checkCase(str) {
+		return MessageInvalidObjectID
+	}
+
+	if _, err := hex.DecodeString(str); err != nil {
+		return MessageInvalidObjectID
+	}
+
+	return ""
+}
@@ -1,20 +1,101 @@
 package validators
 
 const (
-	MessageNotBlank           = "must not be blank"
-	MessageInvalidEmail       = "must be a valid email"
-	MessageNotNumeric         = "must be a valid string with numbers only"
-	MessageInvalidURL         = "must be a valid url"
-	MessageMissing            = "must not be missing from body"
-	MessageEmptyArray         = "array must not be empty"
-	MessageInvalidLength      = "must have %d characters"
-	MessageInvalidLengthSlice = "must have %d elements"
-	MessageNotStringType      = "invalid type. must be string"
-	MessageNotArrayType       = "invalid type. must be array"
-	MessageNotStrIntType      = "invalid type. must be string or integer"
-	MessageNotStrSliceType    = "invalid type. must be string or slice"
-	MessageStrInvalidMin      = "must have more or equal than %d characters"
-	MessageStrInvalidInt      = "must be more or equal than %d"
-	MessageStrInvalidMax      = "must have less or equal than %d characters"
-	MessageIntInvalidMax      = "must be less or equal than %d"
+	MessageNotBlank            = "must not be blank"
+	MessageInvalidEmail        = "must be a valid email"
+	MessageNotNumeric          = "must be a valid string with numbers only"
+	MessageInvalidURL          = "must be a valid url"
+	MessageMissing             = "must not be missing from body"
+	MessageNull                = "must not be null"
+	MessageEmptyArray          = "array must not be empty"
+	MessageInvalidLength       = "must have %d characters"
+	MessageInvalidLengthSlice  = "must have %d elements"
+	MessageNotStringType       = "invalid type. must be string"
+	MessageNotArrayType        = "invalid type. must be array"
+	MessageInvalidJSONType     = "invalid type. must be %s"
+	MessageNotStrIntType       = "invalid type. must be string or integer"
+	MessageNotStrSliceType     = "invalid type. must be string or slice"
+	MessageStrInvalidMin       = "must have more or equal than %d characters"
+	MessageStrInvalidInt       = "must be more or equal than %d"
+	MessageStrInvalidMax       = "must have less or equal than %d characters"
+	MessageIntInvalidMax       = "must be less or equal than %d"
+	MessageNumInvalidMin       = "must be more or equal than %s"
+	MessageNumInvalidMax       = "must be less or equal than %s"
+	MessageInvalidHTTPURL      = "must be a valid http or https url"
+	MessageInvalidURI          = "must be a valid uri"
+	MessageInvalidURN          = "must be a valid urn"
+	MessageURLSchemeNotAllowed = "url scheme must be one of: %s"
+	MessageURLMissingHost      = "url must include a host"
+	MessageURLHostNotAllowed   = "url host is not allowed"
+	MessageNotStrAddrType      = "invalid type. must be string or ip address"
+	MessageNotStrPrefixType    = "invalid type. must be string or ip prefix"
+	MessageInvalidIP           = "must be a valid ip address"
+	MessageInvalidIPv4         = "must be a valid ipv4 address"
+	MessageInvalidIPv6         = "must be a valid ipv6 address"
+	MessageInvalidCIDR         = "must be a valid cidr notation"
+	MessageInvalidMAC          = "must be a valid mac address"
+	MessageInvalidHostname     = "must be a valid hostname"
+	MessageInvalidFQDN         = "must be a fully qualified domain name"
+	MessageInvalidPort         = "must be a valid port number"
+	MessageInvalidHostPort     = "must be a valid host:port"
+	MessageNotStrNumType       = "invalid type. must be string or number"
+	MessageNotEnumType         = "invalid type. must be an enum"
+	MessageNotOneOf            = "must be one of: %s"
+	MessageInvalidEnum         = "must be a valid value"
+	MessagePatternMismatch     = "must match the required pattern"
+	MessageNotContains         = "must contain '%s'"
+	MessageNotContainsAny      = "must contain at least one of '%s'"
+	MessageExcludes            = "must not contain '%s'"
+	MessageNotStartsWith       = "must start with '%s'"
+	MessageNotEndsWith         = "must end with '%s'"
+	MessageNotAlpha            = "must contain only letters"
+	MessageNotAlphaNum         = "must contain only letters and numbers"
+	MessageNotAlphaUnicode     = "must contain only unicode letters"
+	MessageNotASCII            = "must contain only ascii characters"
+	MessageNotPrintASCII       = "must contain only printable ascii characters"
+	MessageNotLowercase        = "must be lowercase"
+	MessageNotUppercase        = "must be uppercase"
+	MessageNotNumber           = "must be a valid number"
+	MessageNotTimeType         = "invalid type. must be time or string"
+	MessageNotPast             = "must be in the past"
+	MessageNotFuture           = "must be in the future"
+	MessageNotAfter            = "must be after %s"
+	MessageNotBefore           = "must be before %s"
+	MessageInvalidDatetime     = "must be a valid datetime in the format %s"
+	MessageInvalidTimeArgument = "invalid time argument: %s"
+	MessageDurationInvalidMin  = "must be more or equal than %s"
+	MessageDurationInvalidMax  = "must be less or equal than %s"
+	MessageNotStrBytesType     = "invalid type. must be string or byte array"
+	MessageInvalidUUID         = "must be a valid uuid"
+	MessageInvalidUUIDVersion  = "must be a valid version %d uuid"
+	MessageInvalidULID         = "must be a valid ulid"
+	MessageInvalidKSUID        = "must be a valid ksuid"
+	MessageInvalidObjectID     = "must be a valid object id"
+	MessageInvalidLuhn         = "must pass the luhn checksum"
+	MessageInvalidCreditCard   = "must be a valid credit card number"
+	MessageInvalidIBAN         = "must be a valid iban"
+	MessageInvalidBIC          = "must be a valid bic"
+	MessageInvalidISBN         = "must be a valid isbn"
+	MessageInvalidISBN10       = "must be a valid isbn-10"
+	MessageInvalidISBN13       = "must be a valid isbn-13"
+	MessageInvalidE164         = "must be a valid e.164 phone number"
+	MessageInvalidCountryCode  = "must be a valid iso 3166-1 alpha-2 country code"
+	MessageInvalidCurrencyCode = "must be a valid iso 4217 currency code"
+	MessageDecodedTooLarge     = "decoded value must have less or equal than %d bytes"
+	MessageInvalidBase64       = "must be a valid base64 string"
+	MessageInvalidBase64URL    = "must be a valid base64url string"
+	MessageInvalidHexadecimal  = "must be a valid hexadecimal string"
+	MessageInvalidJSON         = "must be a valid json document"
+	MessageInvalidSemver       = "must be a valid semantic version"
+	MessageInvalidHexColor     = "must be a valid hex color"
+	MessageInvalidJWT          = "must be a valid jwt"
+	MessageInvalidDataURI      = "must be a valid data uri"
+	MessageSliceInvalidMin     = "must have more or equal than %d elements"
+	MessageSliceInvalidMax     = "must have less or equal than %d elements"
+	MessageNotStructSliceType  = "invalid type. must be a collection of structs"
+	MessageNotStrNumSliceType  = "invalid type. must be a collection of strings or numbers"
+	MessageNotComparableType   = "invalid type. elements must be comparable"
+	MessageUnknownUniqueField  = "invalid unique field %s"
+	MessageNotUnique           = "must contain unique elements"
+	MessageNotContainsElem     = "must contain the element '%s'"
 )
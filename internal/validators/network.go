@@ -0,0 +1,250 @@
+package validators
+
+import (
+	"net"
+	"net/netip"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+var (
+	addrType   = reflect.TypeFor[netip.Addr]()
+	prefixType = reflect.TypeFor[netip.Prefix]()
+
+	hostnameLabelRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+	tldRegex           = regexp.MustCompile(`^[a-zA-Z]{2,63}$|^xn--[a-zA-Z0-9-]{1,59}$`)
+)
+
+// checkAddr validates string fields and netip.Addr fields against accept.
+// Empty strings and zero addresses are skipped like the other string rules.
+func checkAddr(field fieldinfo.Info, accept func(netip.Addr) bool, message string) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if field.Type == addrType && field.GetValue().CanInterface() {
+		addr := field.GetValue().Interface().(netip.Addr)
+		if !addr.IsValid() {
+			return ""
+		}
+		if !accept(addr) {
+			return message
+		}
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStrAddrType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	addr, err := netip.ParseAddr(str)
+	if err != nil || !accept(addr) {
+		return message
+	}
+
+	return ""
+}
+
+func IP(field fieldinfo.Info) string {
+	return checkAddr(field, netip.Addr.IsValid, MessageInvalidIP)
+}
+
+func IPv4(field fieldinfo.Info) string {
+	return checkAddr(field, netip.Addr.Is4, MessageInvalidIPv4)
+}
+
+func IPv6(field fieldinfo.Info) string {
+	return checkAddr(field, netip.Addr.Is6, MessageInvalidIPv6)
+}
+
+func CIDR(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if field.Type == prefixType && field.GetValue().CanInterface() {
+		// the zero prefix is treated as unset, a non-zero one is always valid
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStrPrefixType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	if _, err := netip.ParsePrefix(str); err != nil {
+		return MessageInvalidCIDR
+	}
+
+	return ""
+}
+
+func MAC(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStringType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	if _, err := net.ParseMAC(str); err != nil {
+		return MessageInvalidMAC
+	}
+
+	return ""
+}
+
+// isHostname reports whether str is a valid RFC 1123 hostname.
+func isHostname(str string) bool {
+	if len(str) > 253 {
+		return false
+	}
+
+	for label := range strings.SplitSeq(str, ".") {
+		if !hostnameLabelRegex.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isFQDN reports whether str is a hostname with at least two labels and an
+// alphabetic (or punycode) top-level domain. A trailing dot is allowed.
+func isFQDN(str string) bool {
+	str = strings.TrimSuffix(str, ".")
+	idx := strings.LastIndexByte(str, '.')
+	if idx == -1 {
+		return false
+	}
+
+	return isHostname(str) && tldRegex.MatchString(str[idx+1:])
+}
+
+func Hostname(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStringType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	if !isHostname(str) {
+		return MessageInvalidHostname
+	}
+
+	return ""
+}
+
+func FQDN(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStringType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	if !isFQDN(str) {
+		return MessageInvalidFQDN
+	}
+
+	return ""
+}
+
+func isPort(str string) bool {
+	port, err := strconv.ParseUint(str, 10, 16)
+	return err == nil && port > 0
+}
+
+func Port(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	value := field.GetValue()
+	if value.CanInt() {
+		if port := value.Int(); port < 1 || port > 65535 {
+			return MessageInvalidPort
+		}
+		return ""
+	}
+
+	if value.CanUint() {
+		if port := value.Uint(); port < 1 || port > 65535 {
+			return MessageInvalidPort
+		}
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStrIntType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	if !isPort(str) {
+		return MessageInvalidPort
+	}
+
+	return ""
+}
+
+func HostPort(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStringType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	host, port, err := net.SplitHostPort(str)
+	if err != nil || !isPort(port) {
+		return MessageInvalidHostPort
+	}
+
+	if _, err := netip.ParseAddr(host); err != nil && !isHostname(host) {
+		return MessageInvalidHostPort
+	}
+
+	return ""
+}
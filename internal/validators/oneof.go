@@ -0,0 +1,130 @@
+package validators
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+// Enum is implemented by named types that know whether their own value is valid.
+// Such types can be checked with a bare `validate:"oneof"` tag.
+type Enum interface {
+	IsValid() bool
+}
+
+var oneOfCache sync.Map
+
+func oneOfValues(arg string) []string {
+	if cached, ok := oneOfCache.Load(arg); ok {
+		return cached.([]string)
+	}
+
+	cached, _ := oneOfCache.LoadOrStore(arg, strings.Fields(arg))
+	return cached.([]string)
+}
+
+// OneOf validates that the field is one of the space separated values in the
+// tag, e.g. `validate:"oneof=red green blue"`. Without values, the field type
+// must implement Enum or declare a `Values() []T` method listing its members.
+// Empty strings are skipped like the other string rules do.
+func OneOf(field fieldinfo.Info) string {
+	if field.IsNil() || (field.IsString() && field.String() == "") {
+		return ""
+	}
+
+	arg := field.GetArgumentStr("oneof")
+	if arg == "" {
+		return checkEnum(field)
+	}
+
+	values := oneOfValues(arg)
+	value := field.GetValue()
+	if !isComparableKind(value) {
+		return MessageNotStrNumType
+	}
+
+	if !slices.ContainsFunc(values, func(v string) bool { return equalsArg(value, v) }) {
+		return fmt.Sprintf(MessageNotOneOf, strings.Join(values, ", "))
+	}
+
+	return ""
+}
+
+// isComparableKind reports whether value can be compared against tag arguments
+// with equalsArg.
+func isComparableKind(value reflect.Value) bool {
+	return value.Kind() == reflect.String || value.CanInt() || value.CanUint() || value.CanFloat()
+}
+
+// equalsArg reports whether a string or numeric value equals the tag argument
+// parsed as the same kind.
+func equalsArg(value reflect.Value, arg string) bool {
+	switch {
+	case value.Kind() == reflect.String:
+		return value.String() == arg
+	case value.CanInt():
+		n, err := strconv.ParseInt(arg, 10, 64)
+		return err == nil && n == value.Int()
+	case value.CanUint():
+		n, err := strconv.ParseUint(arg, 10, 64)
+		return err == nil && n == value.Uint()
+	case value.CanFloat():
+		n, err := strconv.ParseFloat(arg, 64)
+		return err == nil && n == value.Float()
+	}
+	return false
+}
+
+func checkEnum(field fieldinfo.Info) string {
+	value := field.GetValue()
+	if !value.CanInterface() {
+		return MessageNotEnumType
+	}
+
+	receiver := addressable(value)
+	if enum, ok := receiver.Interface().(Enum); ok {
+		if !enum.IsValid() {
+			return MessageInvalidEnum
+		}
+		return ""
+	}
+
+	method := receiver.MethodByName("Values")
+	if !method.IsValid() {
+		return MessageNotEnumType
+	}
+
+	methodType := method.Type()
+	if methodType.NumIn() != 0 || methodType.NumOut() != 1 ||
+		methodType.Out(0).Kind() != reflect.Slice || methodType.Out(0).Elem() != value.Type() {
+		return MessageNotEnumType
+	}
+
+	members := method.Call(nil)[0]
+	names := make([]string, members.Len())
+	for i := range members.Len() {
+		if members.Index(i).Equal(value) {
+			return ""
+		}
+		names[i] = fmt.Sprint(members.Index(i).Interface())
+	}
+
+	return fmt.Sprintf(MessageNotOneOf, strings.Join(names, ", "))
+}
+
+// addressable returns a pointer to value, or to a copy of it when value is not
+// addressable, so that methods with pointer receivers can be called as well.
+func addressable(value reflect.Value) reflect.Value {
+	if value.CanAddr() {
+		return value.Addr()
+	}
+
+	ptr := reflect.New(value.Type())
+	ptr.Elem().Set(value)
+	return ptr
+}
@@ -1,6 +1,8 @@
 package validators
 
 import (
+	"encoding/base64"
+
 	"github.com/renxzen/golidator/internal/fieldinfo"
 )
 
@@ -17,4 +19,78 @@ var Registry = map[string]ValidatorFunc{
 	"max":      Max,
 	"len":      Len,
 	"isarray":  IsArray,
+
+	"http_url": HTTPURL,
+	"uri":      URI,
+	"urn":      URN,
+
+	"ip":       IP,
+	"ipv4":     IPv4,
+	"ipv6":     IPv6,
+	"cidr":     CIDR,
+	"mac":      MAC,
+	"hostname": Hostname,
+	"fqdn":     FQDN,
+	"port":     Port,
+	"hostport": HostPort,
+
+	"oneof": OneOf,
+
+	"pattern": Pattern,
+
+	"contains":     Contains,
+	"containsany":  ContainsAny,
+	"excludes":     Excludes,
+	"startswith":   StartsWith,
+	"endswith":     EndsWith,
+	"alpha":        Alpha,
+	"alphanum":     AlphaNum,
+	"alphaunicode": AlphaUnicode,
+	"ascii":        ASCII,
+	"printascii":   PrintASCII,
+	"lowercase":    Lowercase,
+	"uppercase":    Uppercase,
+	"number":       Number,
+
+	"past":     Past,
+	"future":   Future,
+	"after":    After,
+	"before":   Before,
+	"datetime": Datetime,
+
+	"uuid":     uuidValidator("uuid", 0),
+	"uuid1":    uuidValidator("uuid1", 1),
+	"uuid3":    uuidValidator("uuid3", 3),
+	"uuid4":    uuidValidator("uuid4", 4),
+	"uuid5":    uuidValidator("uuid5", 5),
+	"uuid6":    uuidValidator("uuid6", 6),
+	"uuid7":    uuidValidator("uuid7", 7),
+	"uuid8":    uuidValidator("uuid8", 8),
+	"ulid":     ULID,
+	"ksuid":    KSUID,
+	"objectid": ObjectID,
+
+	"luhn":       Luhn,
+	"creditcard": CreditCard,
+	"iban":       IBAN,
+	"bic":        BIC,
+	"isbn":       ISBN,
+	"isbn10":     ISBN10,
+	"isbn13":     ISBN13,
+	"e164":       E164,
+	"iso3166":    CountryCode,
+	"iso4217":    CurrencyCode,
+
+	"base64":       base64Validator("base64", base64.StdEncoding, MessageInvalidBase64),
+	"base64url":    base64Validator("base64url", base64.URLEncoding, MessageInvalidBase64URL),
+	"base64rawurl": base64Validator("base64rawurl", base64.RawURLEncoding, MessageInvalidBase64URL),
+	"hexadecimal":  Hexadecimal,
+	"json":         JSON,
+	"semver":       Semver,
+	"hexcolor":     HexColor,
+	"jwt":          JWT,
+	"datauri":      DataURI,
+
+	"unique":       Unique,
+	"containselem": ContainsElem,
 }
@@ -0,0 +1,151 @@
+package validators
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+var numberRegex = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`)
+
+// checkString runs check against the string value of the field. Nil pointers
+// and empty strings are skipped, leaving presence to required and notblank.
+func checkString(field fieldinfo.Info, check func(str string) string) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStringType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	return check(str)
+}
+
+// checkRunes validates that every rune of the string value satisfies accept.
+func checkRunes(field fieldinfo.Info, accept func(r rune) bool, message string) string {
+	return checkString(field, func(str string) string {
+		for _, r := range str {
+			if !accept(r) {
+				return message
+			}
+		}
+		return ""
+	})
+}
+
+func Contains(field fieldinfo.Info) string {
+	substr := field.GetArgumentStr("contains")
+	return checkString(field, func(str string) string {
+		if !strings.Contains(str, substr) {
+			return fmt.Sprintf(MessageNotContains, substr)
+		}
+		return ""
+	})
+}
+
+func ContainsAny(field fieldinfo.Info) string {
+	chars := field.GetArgumentStr("containsany")
+	return checkString(field, func(str string) string {
+		if !strings.ContainsAny(str, chars) {
+			return fmt.Sprintf(MessageNotContainsAny, chars)
+		}
+		return ""
+	})
+}
+
+func Excludes(field fieldinfo.Info) string {
+	substr := field.GetArgumentStr("excludes")
+	return checkString(field, func(str string) string {
+		if substr != "" && strings.Contains(str, substr) {
+			return fmt.Sprintf(MessageExcludes, substr)
+		}
+		return ""
+	})
+}
+
+func StartsWith(field fieldinfo.Info) string {
+	prefix := field.GetArgumentStr("startswith")
+	return checkString(field, func(str string) string {
+		if !strings.HasPrefix(str, prefix) {
+			return fmt.Sprintf(MessageNotStartsWith, prefix)
+		}
+		return ""
+	})
+}
+
+func EndsWith(field fieldinfo.Info) string {
+	suffix := field.GetArgumentStr("endswith")
+	return checkString(field, func(str string) string {
+		if !strings.HasSuffix(str, suffix) {
+			return fmt.Sprintf(MessageNotEndsWith, suffix)
+		}
+		return ""
+	})
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func Alpha(field fieldinfo.Info) string {
+	return checkRunes(field, isASCIILetter, MessageNotAlpha)
+}
+
+func AlphaNum(field fieldinfo.Info) string {
+	return checkRunes(field, func(r rune) bool {
+		return isASCIILetter(r) || (r >= '0' && r <= '9')
+	}, MessageNotAlphaNum)
+}
+
+func AlphaUnicode(field fieldinfo.Info) string {
+	return checkRunes(field, unicode.IsLetter, MessageNotAlphaUnicode)
+}
+
+func ASCII(field fieldinfo.Info) string {
+	return checkRunes(field, func(r rune) bool {
+		return r <= unicode.MaxASCII
+	}, MessageNotASCII)
+}
+
+func PrintASCII(field fieldinfo.Info) string {
+	return checkRunes(field, func(r rune) bool {
+		return r >= ' ' && r <= '~'
+	}, MessageNotPrintASCII)
+}
+
+func Lowercase(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		if str != strings.ToLower(str) {
+			return MessageNotLowercase
+		}
+		return ""
+	})
+}
+
+func Uppercase(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		if str != strings.ToUpper(str) {
+			return MessageNotUppercase
+		}
+		return ""
+	})
+}
+
+// Number validates a signed decimal number such as "-12", "+3.5" or ".25".
+func Number(field fieldinfo.Info) string {
+	return checkString(field, func(str string) string {
+		if !numberRegex.MatchString(str) {
+			return MessageNotNumber
+		}
+		return ""
+	})
+}
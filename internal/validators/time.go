@@ -0,0 +1,195 @@
+package validators
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+// Now returns the current time used by the time validators.
+// It can be replaced to get deterministic results in tests.
+var Now = time.Now
+
+var timeArgLayouts = []string{time.RFC3339Nano, time.DateTime, time.DateOnly}
+
+// checkTime runs check against the time value of the field. time.Time fields
+// are used as is, while string fields are parsed with the layout of the
+// datetime rule or RFC 3339 by default. Nil pointers, zero times and empty
+// strings are skipped.
+func checkTime(field fieldinfo.Info, check func(t time.Time) string) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if field.IsTime() && field.GetValue().CanInterface() {
+		t := field.Time()
+		if t.IsZero() {
+			return ""
+		}
+		return check(t)
+	}
+
+	if !field.IsString() {
+		return MessageNotTimeType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	layout := field.GetArgumentStr("datetime")
+	if layout == "" {
+		layout = time.RFC3339
+	}
+
+	t, err := time.Parse(layout, str)
+	if err != nil {
+		// reported by the datetime rule when present
+		if field.GetArgumentStr("datetime") != "" {
+			return ""
+		}
+		return fmt.Sprintf(MessageInvalidDatetime, layout)
+	}
+
+	return check(t)
+}
+
+// parseTimeArg parses the argument of the after and before rules. It accepts
+// dates, RFC 3339 timestamps and "now" with an optional offset such as
+// "now+30d", "now-1h" or "now+1h30m".
+func parseTimeArg(arg string) (time.Time, bool) {
+	if offset, ok := strings.CutPrefix(arg, "now"); ok {
+		now := Now()
+		if offset == "" {
+			return now, true
+		}
+
+		if days, ok := strings.CutSuffix(offset, "d"); ok {
+			n, err := strconv.Atoi(days)
+			if err != nil {
+				return time.Time{}, false
+			}
+			return now.AddDate(0, 0, n), true
+		}
+
+		d, err := time.ParseDuration(offset)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return now.Add(d), true
+	}
+
+	for _, layout := range timeArgLayouts {
+		if t, err := time.Parse(layout, arg); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func Past(field fieldinfo.Info) string {
+	return checkTime(field, func(t time.Time) string {
+		if !t.Before(Now()) {
+			return MessageNotPast
+		}
+		return ""
+	})
+}
+
+func Future(field fieldinfo.Info) string {
+	return checkTime(field, func(t time.Time) string {
+		if !t.After(Now()) {
+			return MessageNotFuture
+		}
+		return ""
+	})
+}
+
+func After(field fieldinfo.Info) string {
+	arg := field.GetArgumentStr("after")
+	return checkTime(field, func(t time.Time) string {
+		limit, ok := parseTimeArg(arg)
+		if !ok {
+			return fmt.Sprintf(MessageInvalidTimeArgument, arg)
+		}
+		if !t.After(limit) {
+			return fmt.Sprintf(MessageNotAfter, limit.Format(time.RFC3339))
+		}
+		return ""
+	})
+}
+
+func Before(field fieldinfo.Info) string {
+	arg := field.GetArgumentStr("before")
+	return checkTime(field, func(t time.Time) string {
+		limit, ok := parseTimeArg(arg)
+		if !ok {
+			return fmt.Sprintf(MessageInvalidTimeArgument, arg)
+		}
+		if !t.Before(limit) {
+			return fmt.Sprintf(MessageNotBefore, limit.Format(time.RFC3339))
+		}
+		return ""
+	})
+}
+
+// Datetime validates that a string matches a time layout, e.g. `validate:"datetime=2006-01-02"`.
+func Datetime(field fieldinfo.Info) string {
+	layout := field.GetArgumentStr("datetime")
+	return checkString(field, func(str string) string {
+		if layout == "" {
+			return ""
+		}
+		if _, err := time.Parse(layout, str); err != nil {
+			return fmt.Sprintf(MessageInvalidDatetime, layout)
+		}
+		return ""
+	})
+}
+
+// durationLimit parses the min or max argument of a time.Duration field.
+// Go duration strings like "1h30m" are accepted, plain integers are nanoseconds.
+func durationLimit(field fieldinfo.Info, validatorName string) (time.Duration, bool) {
+	arg := field.GetArgumentStr(validatorName)
+	if arg == "" {
+		return 0, false
+	}
+
+	if d, err := time.ParseDuration(arg); err == nil {
+		return d, true
+	}
+
+	n, err := strconv.ParseInt(arg, 10, 64)
+	return time.Duration(n), err == nil
+}
+
+func minDuration(field fieldinfo.Info) string {
+	limit, exists := durationLimit(field, "min")
+	if !exists {
+		return ""
+	}
+
+	if field.Duration() < limit {
+		return fmt.Sprintf(MessageDurationInvalidMin, limit)
+	}
+
+	return ""
+}
+
+func maxDuration(field fieldinfo.Info) string {
+	limit, exists := durationLimit(field, "max")
+	if !exists {
+		return ""
+	}
+
+	if field.Duration() > limit {
+		return fmt.Sprintf(MessageDurationInvalidMax, limit)
+	}
+
+	return ""
+}
@@ -0,0 +1,201 @@
+package validators
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+var urnRegex = regexp.MustCompile(`^(?i:urn):[A-Za-z0-9][A-Za-z0-9-]{0,30}[A-Za-z0-9]:[A-Za-z0-9()+,\-.:=@;$_!*'%/?#~&]+$`)
+
+// urlOptions holds the constraints parsed from a url, http_url or uri argument.
+// Options are separated by commas in the tag, which fieldinfo.SplitTag joins
+// with ";" into the argument, so both separators work:
+//
+//	validate:"url=https"
+//	validate:"url=scheme:https|wss,host_required"
+//	validate:"url=hosts:example.com|*.example.org;exclude_hosts:internal.example.org"
+type urlOptions struct {
+	schemes      []string
+	hostRequired bool
+	allowHosts   []string
+	denyHosts    []string
+}
+
+var urlOptionsCache sync.Map
+
+func getURLOptions(arg string) *urlOptions {
+	if cached, ok := urlOptionsCache.Load(arg); ok {
+		return cached.(*urlOptions)
+	}
+
+	opts := &urlOptions{}
+	for option := range strings.SplitSeq(arg, ";") {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(option, ":")
+		if !found {
+			if option == "host_required" {
+				opts.hostRequired = true
+				continue
+			}
+			key, value = "scheme", option
+		}
+
+		list := strings.Split(strings.ToLower(value), "|")
+		switch key {
+		case "scheme":
+			opts.schemes = append(opts.schemes, list...)
+		case "hosts":
+			opts.allowHosts = append(opts.allowHosts, list...)
+		case "exclude_hosts":
+			opts.denyHosts = append(opts.denyHosts, list...)
+		}
+	}
+
+	cached, _ := urlOptionsCache.LoadOrStore(arg, opts)
+	return cached.(*urlOptions)
+}
+
+func (o *urlOptions) check(u *url.URL) string {
+	if len(o.schemes) > 0 && !slices.Contains(o.schemes, u.Scheme) {
+		return fmt.Sprintf(MessageURLSchemeNotAllowed, strings.Join(o.schemes, ", "))
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if host == "" {
+		if o.hostRequired || len(o.allowHosts) > 0 {
+			return MessageURLMissingHost
+		}
+		return ""
+	}
+
+	for _, pattern := range o.denyHosts {
+		if matchHost(host, pattern) {
+			return MessageURLHostNotAllowed
+		}
+	}
+
+	if len(o.allowHosts) == 0 {
+		return ""
+	}
+
+	for _, pattern := range o.allowHosts {
+		if matchHost(host, pattern) {
+			return ""
+		}
+	}
+
+	return MessageURLHostNotAllowed
+}
+
+// matchHost reports whether host matches pattern. A pattern starting with "*."
+// matches any subdomain of the remaining domain, but not the domain itself.
+func matchHost(host, pattern string) bool {
+	if suffix, ok := strings.CutPrefix(pattern, "*"); ok {
+		return strings.HasSuffix(host, suffix) && len(host) > len(suffix)
+	}
+	return host == pattern
+}
+
+// URL validates an absolute, hierarchical URL such as "https://example.com/path".
+// Opaque forms like "foo:bar" and relative references are rejected.
+func URL(field fieldinfo.Info) string {
+	if !field.IsRequired && field.IsNil() {
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStringType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	u, err := url.Parse(str)
+	if err != nil || u.Scheme == "" || u.Opaque != "" {
+		return MessageInvalidURL
+	}
+
+	return getURLOptions(field.GetArgumentStr("url")).check(u)
+}
+
+// HTTPURL validates an absolute http or https URL that includes a host.
+func HTTPURL(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStringType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	u, err := url.Parse(str)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return MessageInvalidHTTPURL
+	}
+
+	return getURLOptions(field.GetArgumentStr("http_url")).check(u)
+}
+
+// URI validates any absolute URI as defined by RFC 3986, including opaque
+// forms such as "mailto:user@example.com".
+func URI(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStringType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	u, err := url.Parse(str)
+	if err != nil || u.Scheme == "" {
+		return MessageInvalidURI
+	}
+
+	return getURLOptions(field.GetArgumentStr("uri")).check(u)
+}
+
+// URN validates a uniform resource name as defined by RFC 8141,
+// e.g. "urn:isbn:0451450523".
+func URN(field fieldinfo.Info) string {
+	if field.IsNil() {
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStringType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	if !urnRegex.MatchString(str) {
+		return MessageInvalidURN
+	}
+
+	return ""
+}
@@ -2,8 +2,8 @@ package validators
 
 import (
 	"fmt"
-	"net/url"
 	"regexp"
+	"strconv"
 
 	"github.com/renxzen/golidator/internal/fieldinfo"
 )
@@ -50,39 +50,18 @@ func Email(field fieldinfo.Info) string {
 	return ""
 }
 
-func URL(field fieldinfo.Info) string {
-	if !field.IsRequired && field.IsNil() {
-		return ""
-	}
-
-	if !field.IsString() {
-		return MessageNotStringType
-	}
-
-	str := field.String()
-	if str == "" {
-		return ""
-	}
-
-	if _, err := url.ParseRequestURI(str); err != nil {
-		return MessageInvalidURL
-	}
-
-	return ""
-}
-
 func Min(field fieldinfo.Info) string {
-	if !field.IsRequired && field.IsNil() {
+	if field.IsNil() {
 		return ""
 	}
 
-	if field.IsNil() {
-		return ""
+	if field.IsDuration() {
+		return minDuration(field)
 	}
 
 	minValue, exists := field.GetArgumentInt("min")
 	if !exists {
-		return ""
+		return minNumber(field)
 	}
 
 	if field.IsString() {
@@ -92,6 +71,13 @@ func Min(field fieldinfo.Info) string {
 		return ""
 	}
 
+	if field.IsCollection() {
+		if field.Len() < minValue {
+			return fmt.Sprintf(MessageSliceInvalidMin, minValue)
+		}
+		return ""
+	}
+
 	if field.IsInt() {
 		if field.Int() < int64(minValue) {
 			return fmt.Sprintf(MessageStrInvalidInt, minValue)
@@ -110,13 +96,17 @@ func Min(field fieldinfo.Info) string {
 }
 
 func Max(field fieldinfo.Info) string {
-	if !field.IsRequired && field.IsNil() {
+	if field.IsNil() {
 		return ""
 	}
 
+	if field.IsDuration() {
+		return maxDuration(field)
+	}
+
 	maxValue, exists := field.GetArgumentInt("max")
 	if !exists {
-		return ""
+		return maxNumber(field)
 	}
 
 	if field.IsString() {
@@ -126,6 +116,13 @@ func Max(field fieldinfo.Info) string {
 		return ""
 	}
 
+	if field.IsCollection() {
+		if field.Len() > maxValue {
+			return fmt.Sprintf(MessageSliceInvalidMax, maxValue)
+		}
+		return ""
+	}
+
 	if field.IsInt() {
 		if field.Int() > int64(maxValue) {
 			return fmt.Sprintf(MessageIntInvalidMax, maxValue)
@@ -143,8 +140,48 @@ func Max(field fieldinfo.Info) string {
 	return MessageNotStrIntType
 }
 
+// minNumber checks numbers against a bound that is not a non-negative integer,
+// e.g. min=0.5 or min=-10.
+func minNumber(field fieldinfo.Info) string {
+	arg := field.GetArgumentStr("min")
+	bound, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return ""
+	}
+
+	if value, ok := number(field); ok && value < bound {
+		return fmt.Sprintf(MessageNumInvalidMin, arg)
+	}
+	return ""
+}
+
+// maxNumber checks numbers against a bound that is not a non-negative integer,
+// e.g. max=99.99.
+func maxNumber(field fieldinfo.Info) string {
+	arg := field.GetArgumentStr("max")
+	bound, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return ""
+	}
+
+	if value, ok := number(field); ok && value > bound {
+		return fmt.Sprintf(MessageNumInvalidMax, arg)
+	}
+	return ""
+}
+
+func number(field fieldinfo.Info) (float64, bool) {
+	switch {
+	case field.IsInt():
+		return float64(field.Int()), true
+	case field.IsFloat():
+		return field.Float(), true
+	}
+	return 0, false
+}
+
 func NotEmpty(field fieldinfo.Info) string {
-	if !field.IsSlice() {
+	if !field.IsCollection() {
 		return MessageNotArrayType
 	}
 
@@ -173,7 +210,7 @@ func Len(field fieldinfo.Info) string {
 		return ""
 	}
 
-	if !field.IsString() && !field.IsSlice() {
+	if !field.IsString() && !field.IsCollection() {
 		return MessageNotStrSliceType
 	}
 
@@ -181,7 +218,7 @@ func Len(field fieldinfo.Info) string {
 		return fmt.Sprintf(MessageInvalidLength, fieldLength)
 	}
 
-	if field.IsSlice() && field.Len() != fieldLength {
+	if field.IsCollection() && field.Len() != fieldLength {
 		return fmt.Sprintf(MessageInvalidLengthSlice, fieldLength)
 	}
 
@@ -209,3 +246,24 @@ func Numeric(field fieldinfo.Info) string {
 
 	return ""
 }
+
+func Pattern(field fieldinfo.Info) string {
+	if field.IsNil() || field.Pattern == nil {
+		return ""
+	}
+
+	if !field.IsString() {
+		return MessageNotStringType
+	}
+
+	str := field.String()
+	if str == "" {
+		return ""
+	}
+
+	if !field.Pattern.MatchString(str) {
+		return MessagePatternMismatch
+	}
+
+	return ""
+}
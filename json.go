@@ -0,0 +1,89 @@
+package golidator
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/renxzen/golidator/internal/engine"
+	"github.com/renxzen/golidator/internal/form"
+)
+
+// ValidateJSON decodes a JSON document into T and validates it, tracking which
+// keys were present. The required rule then means "present and not null" for
+// every field, so `Age int validate:"required"` accepts 0 but reports a missing
+// key, and the other rules skip absent and null keys as they skip nil pointers.
+// Values of the wrong JSON type are reported with the code CodeInvalidType in
+// place of the rule errors of their field.
+// Malformed documents are returned as a *DecodeError. Fields tagged `json:"-"` are
+// not decoded, so their rules run against their value. T may be a pointer to a
+// struct, such as *User.
+func ValidateJSON[T any](data []byte) (T, []ValidationError, error) {
+	return ValidateJSONWith[T](defaultValidator, data)
+}
+
+// DecodeError is returned by ValidateJSON when the document is malformed or
+// cannot be decoded into T, as opposed to errors in the rules of T.
+type DecodeError struct {
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateJSONWith is like ValidateJSON but validates with the given Validator
+func ValidateJSONWith[T any](v *Validator, data []byte) (T, []ValidationError, error) {
+	var model T
+
+	presence, err := engine.ParsePresence(data)
+	if err != nil {
+		return model, nil, &DecodeError{Err: err}
+	}
+
+	// json.Unmarshal reports the first value of the wrong type, the others are
+	// found by checking the document against T.
+	var typeErr *json.UnmarshalTypeError
+	if err := json.Unmarshal(data, &model); err != nil && (!errors.As(err, &typeErr) || typeErr.Field == "") {
+		return model, nil, &DecodeError{Err: err}
+	}
+
+	typeErrors := v.engine.CheckTypes(reflect.TypeFor[T](), presence)
+	if typeErr != nil && len(typeErrors) == 0 {
+		// Types decoding themselves, e.g. with UnmarshalJSON, are not checked.
+		var path Path
+		for _, name := range strings.Split(typeErr.Field, ".") {
+			path = append(path, PathSegment{Name: name, Key: name})
+		}
+		typeErrors = append(typeErrors, engine.TypeError{Path: path, Type: typeErr.Type})
+	}
+
+	conversionErrors := make([]ValidationError, len(typeErrors))
+	for i, typeErr := range typeErrors {
+		conversionErrors[i] = ValidationError{
+			Field:  v.engine.FormatPath(typeErr.Path),
+			Errors: []string{form.TypeMessage(typeErr.Type)},
+			Codes:  []string{CodeInvalidType},
+			Path:   typeErr.Path,
+		}
+	}
+
+	// A pointer type parameter such as *User is validated through the pointer
+	// it was decoded to.
+	var validated any = &model
+	if reflect.TypeFor[T]().Kind() == reflect.Pointer {
+		validated = model
+	}
+
+	validationErrors, err := v.engine.ValidatePresence(validated, presence)
+	if err != nil {
+		return model, nil, err
+	}
+
+	return model, mergeConversionErrors(conversionErrors, validationErrors), nil
+}
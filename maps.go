@@ -0,0 +1,14 @@
+package golidator
+
+// ValidateMap validates a dynamic document, such as a map decoded from JSON or
+// YAML, with rules written in tag syntax and keyed by the path of the value,
+// e.g. "user.email", "items[0].name" or "items[*].name"
+func ValidateMap(data map[string]any, rules map[string]string) ([]ValidationError, error) {
+	return defaultValidator.ValidateMap(data, rules)
+}
+
+// ValidateMap validates a dynamic document with rules keyed by path. Invalid
+// keys and rules are returned as an error.
+func (v *Validator) ValidateMap(data map[string]any, rules map[string]string) ([]ValidationError, error) {
+	return v.engine.ValidateMap(data, rules)
+}
@@ -0,0 +1,145 @@
+// Package openapi generates OpenAPI 3.1 components.schemas entries from the
+// json and validate tags of registered types, for example in a go generate step.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/renxzen/golidator"
+	"github.com/renxzen/golidator/internal/cache"
+	"github.com/renxzen/golidator/internal/fieldinfo"
+	"github.com/renxzen/golidator/internal/schema"
+)
+
+const (
+	// RefPrefix is the prefix of $ref to component schemas.
+	RefPrefix = "#/components/schemas/"
+
+	DefaultDescriptionTag = "doc"
+	DefaultExampleTag     = "example"
+)
+
+// Document holds the generated components, ready to be merged into a spec.
+type Document struct {
+	Components Components `json:"components"`
+}
+
+// Components holds the schemas of the registered types and the struct types
+// they refer to, by type name.
+type Components struct {
+	Schemas map[string]*golidator.Schema `json:"schemas"`
+}
+
+type config struct {
+	tag            string
+	descriptionTag string
+	exampleTag     string
+}
+
+// Option configures a Registry.
+type Option func(config *config)
+
+// WithTagName reads rules from the given tag instead of "validate".
+func WithTagName(name string) Option {
+	return func(config *config) {
+		config.tag = name
+	}
+}
+
+// WithDescriptionTag reads field descriptions from the given tag instead of "doc".
+func WithDescriptionTag(name string) Option {
+	return func(config *config) {
+		config.descriptionTag = name
+	}
+}
+
+// WithExampleTag reads field examples from the given tag instead of "example".
+func WithExampleTag(name string) Option {
+	return func(config *config) {
+		config.exampleTag = name
+	}
+}
+
+// Registry collects the types to publish as component schemas.
+type Registry struct {
+	config config
+	types  []reflect.Type
+}
+
+// New returns an empty Registry configured with the given options.
+func New(opts ...Option) *Registry {
+	r := &Registry{config: config{
+		tag:            fieldinfo.ValidateTag,
+		descriptionTag: DefaultDescriptionTag,
+		exampleTag:     DefaultExampleTag,
+	}}
+	for _, opt := range opts {
+		opt(&r.config)
+	}
+	return r
+}
+
+// Register adds the struct types of the given sample values, e.g. User{}. It
+// panics when a value is not a struct or a pointer to one.
+func (r *Registry) Register(models ...any) *Registry {
+	for _, model := range models {
+		t := reflect.TypeOf(model)
+		for t != nil && t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		if t == nil || t.Kind() != reflect.Struct || t.Name() == "" {
+			panic(fmt.Sprintf("openapi: %T is not a named struct type", model))
+		}
+		r.types = append(r.types, t)
+	}
+	return r
+}
+
+// Components builds the schemas of the registered types. Nested named structs
+// get their own entry and are referenced with $ref.
+func (r *Registry) Components() (*Components, error) {
+	options := fieldinfo.DefaultOptions()
+	options.Tag = r.config.tag
+	typeCache := cache.NewTypeCache(options)
+
+	generator := &schema.Generator{
+		TypeInfo:       typeCache.Get,
+		RefPrefix:      RefPrefix,
+		Defs:           map[string]*golidator.Schema{},
+		DescriptionTag: r.config.descriptionTag,
+		ExampleTag:     r.config.exampleTag,
+	}
+
+	for _, t := range r.types {
+		if _, err := generator.Type(t); err != nil {
+			return nil, err
+		}
+	}
+
+	return &Components{Schemas: generator.Defs}, nil
+}
+
+// JSON returns the components as an indented JSON document.
+func (r *Registry) JSON() ([]byte, error) {
+	components, err := r.Components()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(Document{Components: *components}, "", "  ")
+}
+
+// YAML returns the components as a YAML document.
+func (r *Registry) YAML() ([]byte, error) {
+	components, err := r.Components()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(Document{Components: *components})
+	if err != nil {
+		return nil, err
+	}
+	return jsonToYAML(data)
+}
@@ -0,0 +1,159 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/renxzen/golidator/openapi"
+)
+
+type Address struct {
+	Street string `json:"street" validate:"required" doc:"Street and number" example:"1 Main St"`
+}
+
+type User struct {
+	Email   string    `json:"email"   validate:"required,email" doc:"Contact email" example:"john@example.com"`
+	Age     int       `json:"age"     validate:"min=18"         example:"30"`
+	Admin   bool      `json:"admin"                             example:"false"`
+	Tags    []string  `json:"tags"    validate:"max=3"          example:"a, b"`
+	Address *Address  `json:"address" validate:"required"       doc:"Home address"`
+	Others  []Address `json:"others"  binding:"required"`
+}
+
+func TestComponentsJSON(t *testing.T) {
+	data, err := openapi.New().Register(User{}).JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{
+		"components": {
+			"schemas": {
+				"Address": {
+					"type": "object",
+					"properties": {
+						"street": {"type": "string", "description": "Street and number", "examples": ["1 Main St"]}
+					},
+					"required": ["street"]
+				},
+				"User": {
+					"type": "object",
+					"properties": {
+						"email": {"type": "string", "format": "email", "description": "Contact email", "examples": ["john@example.com"]},
+						"age": {"type": "integer", "minimum": 18, "examples": [30]},
+						"admin": {"type": "boolean", "examples": [false]},
+						"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 3, "examples": [["a", "b"]]},
+						"address": {"$ref": "#/components/schemas/Address", "description": "Home address"},
+						"others": {"type": "array", "items": {"$ref": "#/components/schemas/Address"}}
+					},
+					"required": ["email", "address"]
+				}
+			}
+		}
+	}`
+
+	assertJSONEqual(t, data, expected)
+}
+
+func TestComponentsOptions(t *testing.T) {
+	data, err := openapi.New(
+		openapi.WithTagName("binding"),
+		openapi.WithDescriptionTag("label"),
+		openapi.WithExampleTag("sample"),
+	).Register(&User{}).JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var document openapi.Document
+	if err := json.Unmarshal(data, &document); err != nil {
+		t.Fatal(err)
+	}
+
+	user := document.Components.Schemas["User"]
+	if user == nil || !reflect.DeepEqual(user.Required, []string{"others"}) {
+		t.Errorf("Expected others to be required with the binding tag, got %s", data)
+	}
+	if email := user.Properties["email"]; email.Description != "" || email.Examples != nil {
+		t.Errorf("Expected no description or example, got %+v", email)
+	}
+}
+
+func TestComponentsYAML(t *testing.T) {
+	data, err := openapi.New().Register(Address{}).YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := strings.Join([]string{
+		"components:",
+		"  schemas:",
+		"    Address:",
+		"      type: object",
+		"      properties:",
+		"        street:",
+		"          description: Street and number",
+		"          type: string",
+		"          examples:",
+		`            - "1 Main St"`,
+		"      required:",
+		"        - street",
+		"",
+	}, "\n")
+
+	if string(data) != expected {
+		t.Errorf("Expected YAML:\n%s\ngot:\n%s", expected, data)
+	}
+}
+
+func TestComponentsYAMLQuoting(t *testing.T) {
+	type Quoted struct {
+		Ref     Address  `json:"ref"`
+		Values  []string `json:"values" validate:"oneof=yes no 1.5 a:b"`
+		Pattern string   `json:"pattern" validate:"pattern=^#[0-9]+$"`
+	}
+
+	data, err := openapi.New().Register(Quoted{}).YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{
+		`        $ref: "#/components/schemas/Address"`,
+		`            - "yes"`,
+		`            - "no"`,
+		`            - "1.5"`,
+		`            - "a:b"`,
+		`          pattern: "^#[0-9]+$"`,
+	} {
+		if !strings.Contains(string(data), line+"\n") {
+			t.Errorf("Expected line %q in:\n%s", line, data)
+		}
+	}
+}
+
+func TestRegisterPanicsOnNonStruct(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected Register to panic")
+		}
+	}()
+	openapi.New().Register("not a struct")
+}
+
+func assertJSONEqual(t *testing.T, data []byte, expected string) {
+	t.Helper()
+
+	var got, want any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(expected), &want); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected JSON:\n%s", data)
+	}
+}
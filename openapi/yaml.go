@@ -0,0 +1,152 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// plainScalarRegex matches strings that can be written in YAML without quotes.
+var plainScalarRegex = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$ ./()-]*$`)
+
+var reservedScalars = map[string]bool{
+	"true": true, "false": true, "null": true, "yes": true, "no": true,
+	"on": true, "off": true, "y": true, "n": true, "~": true,
+}
+
+// node is a JSON value that keeps the order of object keys.
+type node struct {
+	keys   []string
+	fields []*node
+	items  []*node
+	object bool
+	array  bool
+	scalar string
+}
+
+// jsonToYAML converts a JSON document into block style YAML, keeping the order
+// of object keys.
+func jsonToYAML(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	root, err := parseNode(decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	for _, line := range root.lines() {
+		buf.WriteString(line)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+func parseNode(decoder *json.Decoder) (*node, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch token := token.(type) {
+	case json.Delim:
+		n := &node{object: token == '{', array: token == '['}
+		for decoder.More() {
+			if n.object {
+				key, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+				n.keys = append(n.keys, key.(string))
+			}
+
+			child, err := parseNode(decoder)
+			if err != nil {
+				return nil, err
+			}
+			if n.object {
+				n.fields = append(n.fields, child)
+			} else {
+				n.items = append(n.items, child)
+			}
+		}
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		return n, nil
+	case string:
+		return &node{scalar: quote(token)}, nil
+	case json.Number:
+		return &node{scalar: token.String()}, nil
+	case bool:
+		return &node{scalar: fmt.Sprint(token)}, nil
+	case nil:
+		return &node{scalar: "null"}, nil
+	}
+
+	return nil, fmt.Errorf("unexpected JSON token %v", token)
+}
+
+// inline returns the node written on the line of its key, or false when it
+// spans several lines.
+func (n *node) inline() (string, bool) {
+	switch {
+	case n.object && len(n.keys) == 0:
+		return "{}", true
+	case n.array && len(n.items) == 0:
+		return "[]", true
+	case n.object || n.array:
+		return "", false
+	}
+	return n.scalar, true
+}
+
+// lines renders a multi-line object or array without indentation.
+func (n *node) lines() []string {
+	var lines []string
+
+	if n.array {
+		for _, item := range n.items {
+			if value, ok := item.inline(); ok {
+				lines = append(lines, "- "+value)
+				continue
+			}
+			for i, line := range item.lines() {
+				if i == 0 {
+					lines = append(lines, "- "+line)
+				} else {
+					lines = append(lines, "  "+line)
+				}
+			}
+		}
+		return lines
+	}
+
+	for i, key := range n.keys {
+		field := n.fields[i]
+		if value, ok := field.inline(); ok {
+			lines = append(lines, quote(key)+": "+value)
+			continue
+		}
+
+		lines = append(lines, quote(key)+":")
+		for _, line := range field.lines() {
+			lines = append(lines, "  "+line)
+		}
+	}
+	return lines
+}
+
+// quote returns s as a plain YAML scalar when it cannot be mistaken for another
+// type, or as a double quoted JSON string, which YAML accepts as well.
+func quote(s string) string {
+	if plainScalarRegex.MatchString(s) && !reservedScalars[strings.ToLower(s)] && !strings.HasSuffix(s, " ") {
+		return s
+	}
+
+	quoted, _ := json.Marshal(s)
+	return string(quoted)
+}
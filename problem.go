@@ -0,0 +1,71 @@
+package golidator
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemContentType is the media type of RFC 9457 problem details
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 9457 (formerly RFC 7807) problem details document
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+}
+
+// InvalidParam describes one failed rule of a field in a Problem
+type InvalidParam struct {
+	// Name is the field as rendered in ValidationError.Field
+	Name string `json:"name"`
+	// Reason is the error message
+	Reason string `json:"reason"`
+	// Code is the name of the rule that failed, e.g. "email"
+	Code string `json:"code,omitempty"`
+	// Pointer is the RFC 6901 JSON Pointer to the field in the request body,
+	// built from the json keys of the fields whatever their Name
+	Pointer string `json:"pointer"`
+}
+
+// NewProblem converts validation errors into a Problem with the given status,
+// e.g. http.StatusBadRequest or http.StatusUnprocessableEntity. Each message
+// of each field becomes an entry of InvalidParams.
+func NewProblem(status int, errs []ValidationError) *Problem {
+	problem := &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+
+	for _, e := range errs {
+		pointer := e.Path.DocumentPointer()
+		if len(e.Path) == 0 {
+			pointer = Path{{Name: e.Field}}.DocumentPointer()
+		}
+
+		for i, reason := range e.Errors {
+			param := InvalidParam{
+				Name:    e.Field,
+				Reason:  reason,
+				Pointer: pointer,
+			}
+			if i < len(e.Codes) {
+				param.Code = e.Codes[i]
+			}
+			problem.InvalidParams = append(problem.InvalidParams, param)
+		}
+	}
+
+	return problem
+}
+
+// Write writes the problem as an application/problem+json response
+func (p *Problem) Write(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
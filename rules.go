@@ -0,0 +1,61 @@
+package golidator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+)
+
+// RuleSet adds rules to the fields of a struct type without struct tags, such
+// as types generated from protobuf or SQL definitions
+type RuleSet[T any] struct {
+	t reflect.Type
+}
+
+// Rules returns the RuleSet of the struct type T. Registered rules are merged
+// with the tags of the fields, taking precedence for rules present in both, and
+// apply to every Validator.
+func Rules[T any]() *RuleSet[T] {
+	t := reflect.TypeFor[T]()
+	if t.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("rules type must be a struct, got %s", t))
+	}
+	return &RuleSet[T]{t: t}
+}
+
+// Field adds rules to the field whose address is returned by selector, e.g.
+// Field(func(u *User) any { return &u.Email }, "required,email")
+func (r *RuleSet[T]) Field(selector func(model *T) any, rules string) *RuleSet[T] {
+	model := new(T)
+	base := reflect.ValueOf(model).Pointer()
+
+	selected := reflect.ValueOf(selector(model))
+	if selected.Kind() == reflect.Pointer && !selected.IsNil() {
+		for i := range r.t.NumField() {
+			field := r.t.Field(i)
+			if selected.Pointer() == base+field.Offset && selected.Type().Elem() == field.Type {
+				return r.register(i, rules)
+			}
+		}
+	}
+	panic(fmt.Sprintf("selector must return the address of a field of %s", r.t))
+}
+
+// FieldByName adds rules to the field with the given Go name, e.g.
+// FieldByName("Email", "required,email")
+func (r *RuleSet[T]) FieldByName(name string, rules string) *RuleSet[T] {
+	field, ok := r.t.FieldByName(name)
+	if !ok || len(field.Index) != 1 {
+		panic(fmt.Sprintf("%s has no field %s", r.t, name))
+	}
+	return r.register(field.Index[0], rules)
+}
+
+func (r *RuleSet[T]) register(index int, rules string) *RuleSet[T] {
+	if _, err := fieldinfo.ParseTag(rules); err != nil {
+		panic(fmt.Sprintf("rules of %s.%s: %v", r.t, r.t.Field(index).Name, err))
+	}
+	fieldinfo.RegisterRules(r.t, index, rules)
+	return r
+}
@@ -0,0 +1,57 @@
+package golidator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/renxzen/golidator/internal/schema"
+)
+
+// Schema is a JSON Schema (draft 2020-12) document
+type Schema = schema.Schema
+
+// SchemaFunc adds the keywords of a rule to the schema of a field
+type SchemaFunc = schema.RuleFunc
+
+// RegisterSchemaFunc sets the schema keywords contributed by a rule, typically
+// one added with AddValidator. Unknown keywords can be set in Schema.Extra.
+func RegisterSchemaFunc(rule string, fn SchemaFunc) {
+	if rule == "" || fn == nil {
+		panic("schema rule name cannot be empty")
+	}
+	schema.Rules[rule] = fn
+}
+
+// JSONSchema returns the JSON Schema of the struct type of model, e.g.
+// JSONSchema(User{}), built from its json and validate tags. Named struct types
+// of its fields are defined in $defs.
+func JSONSchema(model any) (*Schema, error) {
+	return defaultValidator.JSONSchema(model)
+}
+
+// JSONSchema returns the JSON Schema of the struct type of model, reading rules
+// from the tag of the Validator
+func (v *Validator) JSONSchema(model any) (*Schema, error) {
+	t := reflect.TypeOf(model)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("model must be a struct, got %T", model)
+	}
+
+	generator := &schema.Generator{
+		TypeInfo:  v.engine.TypeInfo,
+		RefPrefix: "#/$defs/",
+	}
+
+	root, err := generator.Object(t)
+	if err != nil {
+		return nil, err
+	}
+
+	root.Schema = schema.Draft
+	root.Title = t.Name()
+	root.Defs = generator.Defs
+	return root, nil
+}
@@ -0,0 +1,77 @@
+package golidator
+
+import (
+	"github.com/renxzen/golidator/internal/engine"
+)
+
+// Validator validates structs with its own tag name, naming, path rendering and
+// type cache. Validators, patterns and custom types are shared by all instances.
+type Validator struct {
+	engine *engine.Engine
+}
+
+// Option configures a Validator
+type Option func(config *engine.Config)
+
+var defaultValidator = New()
+
+// New returns a Validator configured with the given options. Without options it
+// behaves like the package level Validate.
+func New(opts ...Option) *Validator {
+	v := &Validator{engine: engine.New(engine.DefaultConfig())}
+	v.configure(opts...)
+	return v
+}
+
+func (v *Validator) configure(opts ...Option) {
+	v.engine.Configure(func(config *engine.Config) {
+		for _, opt := range opts {
+			opt(config)
+		}
+	})
+}
+
+// Validate validates a struct and returns validation errors
+func (v *Validator) Validate(model any) ([]ValidationError, error) {
+	return v.engine.Validate(model)
+}
+
+// WithTagName reads rules from the given tag instead of "validate", e.g. "binding".
+// An empty name restores "validate".
+func WithTagName(name string) Option {
+	return func(config *engine.Config) {
+		if name == "" {
+			name = ValidateTag
+		}
+		config.Tag = name
+	}
+}
+
+// WithCaching enables or disables type caching
+func WithCaching(enabled bool) Option {
+	return func(config *engine.Config) {
+		config.UseCaching = enabled
+	}
+}
+
+// WithFieldNameFunc sets how fields are named in errors, e.g. TagNameFunc("form").
+// Passing nil restores the json tag.
+func WithFieldNameFunc(fn FieldNameFunc) Option {
+	return func(config *engine.Config) {
+		if fn == nil {
+			fn = TagNameFunc(JsonTag)
+		}
+		config.FieldName = fn
+	}
+}
+
+// WithPathFormatter sets how the Field of a ValidationError is rendered from its
+// Path, e.g. Path.JSONPointer. Passing nil restores the dotted notation.
+func WithPathFormatter(formatter PathFormatter) Option {
+	return func(config *engine.Config) {
+		if formatter == nil {
+			formatter = Path.String
+		}
+		config.PathFormat = formatter
+	}
+}
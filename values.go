@@ -0,0 +1,78 @@
+package golidator
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+
+	"github.com/renxzen/golidator/internal/fieldinfo"
+	"github.com/renxzen/golidator/internal/form"
+)
+
+// CodeInvalidType is the code of values that cannot be converted to the type of their field
+const CodeInvalidType = "type"
+
+// ValidateValues decodes form or query values into T and validates the result
+// with the rules of T. Keys are matched to fields by their form tag, then their
+// json tag, then their name. Values that cannot be converted, e.g. "abc" for an
+// int, are reported with the code CodeInvalidType in place of the rule errors of
+// their field. The populated struct is returned along with the errors. T may be
+// a pointer to a struct, such as *User.
+func ValidateValues[T any](values url.Values) (T, []ValidationError, error) {
+	return ValidateValuesWith[T](defaultValidator, values)
+}
+
+// ValidateValuesWith is like ValidateValues but validates with the given Validator
+func ValidateValuesWith[T any](v *Validator, values url.Values) (T, []ValidationError, error) {
+	var model T
+
+	// A pointer type parameter such as *User is decoded into a new struct.
+	var target any = &model
+	if t := reflect.TypeFor[T](); t.Kind() == reflect.Pointer {
+		model = reflect.New(t.Elem()).Interface().(T)
+		target = model
+	}
+
+	var conversionErrors form.Errors
+	if err := form.Decode(values, target); err != nil && !errors.As(err, &conversionErrors) {
+		return model, nil, err
+	}
+
+	validationErrors, err := v.Validate(target)
+	if err != nil {
+		return model, nil, err
+	}
+	results := make([]ValidationError, len(conversionErrors))
+	for i, conversionErr := range conversionErrors {
+		path := Path{}.FieldKey(v.engine.FieldName(conversionErr.Field), fieldinfo.JSONKey(conversionErr.Field))
+		results[i] = ValidationError{
+			Field:  v.engine.FormatPath(path),
+			Errors: []string{conversionErr.Message},
+			Codes:  []string{CodeInvalidType},
+			Path:   path,
+		}
+	}
+
+	return model, mergeConversionErrors(results, validationErrors), nil
+}
+
+// mergeConversionErrors lists the conversion errors followed by the rule errors
+// of the fields that were converted, as rules of the others ran on zero values.
+func mergeConversionErrors(conversionErrors, validationErrors []ValidationError) []ValidationError {
+	if len(conversionErrors) == 0 {
+		return validationErrors
+	}
+
+	invalid := make(map[string]bool, len(conversionErrors))
+	for _, conversionErr := range conversionErrors {
+		invalid[conversionErr.Path.String()] = true
+	}
+
+	results := conversionErrors
+	for _, validationError := range validationErrors {
+		if !invalid[validationError.Path.String()] {
+			results = append(results, validationError)
+		}
+	}
+	return results
+}